@@ -1,16 +1,79 @@
 package main
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 
+	"github.com/gthesheep/terraform-provider-segment/pkg/export"
 	"github.com/gthesheep/terraform-provider-segment/pkg/provider"
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+)
+
+var (
+	// version is set by goreleaser
+	version string = "dev"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		if err := runExport(os.Args[2:]); err != nil {
+			fmt.Fprintf(os.Stderr, "export: %s\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: func() *schema.Provider {
-			return provider.Provider()
-		},
+		ProviderFunc: provider.New(version),
 	})
 }
+
+// runExport writes the sources, destinations and warehouses of the workspace
+// as Terraform configuration, authenticating like the provider does.
+func runExport(args []string) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	apiURL := flags.String("api-url", envOrDefault("SEGMENT_API_URL", "https://api.segmentapis.com"), "Base Api URL to use, defaults to SEGMENT_API_URL")
+	output := flags.String("output", "", "File to write the configuration to, defaults to stdout")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	token := os.Getenv("SEGMENT_API_TOKEN")
+	if token == "" {
+		return fmt.Errorf("SEGMENT_API_TOKEN must be set")
+	}
+
+	c, err := segment.NewClient(*apiURL, &token)
+	if err != nil {
+		return err
+	}
+	c.UserAgent = fmt.Sprintf("terraform-provider-segment/%s export", version)
+
+	workspace, err := export.ReadWorkspace(c)
+	if err != nil {
+		return err
+	}
+
+	var out io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+
+	return workspace.WriteHCL(out)
+}
+
+func envOrDefault(key string, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
@@ -0,0 +1,152 @@
+package data_sources
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceAuditEvents() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAuditEventsRead,
+
+		Schema: map[string]*schema.Schema{
+			"start_time": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only list events from this time on, as an RFC 3339 timestamp. Defaults to 7 days before `end_time`, so the whole audit trail isn't read",
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"end_time": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only list events before this time, as an RFC 3339 timestamp. Defaults to now",
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"resource_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list events about this type of resource, i.e. `source`",
+			},
+			"resource_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list events about the resource with this identifier",
+			},
+			"actor": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list events made by this actor, matched against the identifier or email of the actor, case insensitively. Changes made by the provider are made by the token it's configured with, so with a token only used by Terraform its identifier tells them apart from edits in the Segment app",
+			},
+			"events": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Matching audit events",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "Identifier of the event",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"timestamp": {
+							Description: "Time of the event",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"type": {
+							Description: "Type of the event, i.e. `Source Created`",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"actor": {
+							Description: "Identifier of the user or token that made the change",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"actor_email": {
+							Description: "Email of the user that made the change, empty for tokens",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"resource_id": {
+							Description: "Identifier of the resource changed",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"resource_type": {
+							Description: "Type of the resource changed",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"resource_name": {
+							Description: "Name of the resource changed",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAuditEventsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	startTime := d.Get("start_time").(string)
+	endTime := d.Get("end_time").(string)
+	if startTime == "" {
+		end := time.Now()
+		if endTime != "" {
+			var err error
+			if end, err = time.Parse(time.RFC3339, endTime); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		startTime = end.AddDate(0, 0, -7).UTC().Format(time.RFC3339)
+	}
+
+	auditEvents, err := c.ListAuditEvents(segment.AuditEventsQuery{
+		StartTime:    startTime,
+		EndTime:      endTime,
+		ResourceID:   d.Get("resource_id").(string),
+		ResourceType: d.Get("resource_type").(string),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	actor := d.Get("actor").(string)
+
+	flatEvents := make([]interface{}, 0, len(auditEvents))
+	for _, event := range auditEvents {
+		if actor != "" && !strings.EqualFold(event.Actor, actor) && !strings.EqualFold(event.ActorEmail, actor) {
+			continue
+		}
+		flatEvents = append(flatEvents, map[string]interface{}{
+			"id":            event.ID,
+			"timestamp":     event.Timestamp,
+			"type":          event.Type,
+			"actor":         event.Actor,
+			"actor_email":   event.ActorEmail,
+			"resource_id":   event.ResourceID,
+			"resource_type": event.ResourceType,
+			"resource_name": event.ResourceName,
+		})
+	}
+
+	if err := d.Set("events", flatEvents); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(queryID(d.Get("start_time"), endTime, d.Get("resource_id"), d.Get("resource_type"), actor))
+
+	return diags
+}
@@ -0,0 +1,235 @@
+package data_sources
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceSourceCatalog() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSourceCatalogRead,
+		Schema:      catalogSchema("source"),
+	}
+}
+
+func DataSourceDestinationCatalog() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDestinationCatalogRead,
+		Schema:      catalogSchema("destination"),
+	}
+}
+
+func DataSourceWarehouseCatalog() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceWarehouseCatalogRead,
+		Schema:      catalogSchema("warehouse"),
+	}
+}
+
+func catalogSchema(kind string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"slug": &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Slug of the " + kind + " type to look up",
+			ExactlyOneOf: []string{"slug", "metadata_id"},
+		},
+		"metadata_id": &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Identifier of the " + kind + " type in the catalog to look up",
+			ExactlyOneOf: []string{"slug", "metadata_id"},
+		},
+		"name": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Name of the " + kind + " type",
+		},
+		"description": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Description of the " + kind + " type",
+		},
+		"categories": &schema.Schema{
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Categories the " + kind + " type is listed under, empty for warehouses",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"logos": &schema.Schema{
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "URLs of the logos of the " + kind + " type",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"default": {
+						Description: "URL of the default logo",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"mark": {
+						Description: "URL of the logo mark",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"alt": {
+						Description: "URL of the alternative logo",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"options": &schema.Schema{
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Settings a " + kind + " of this type accepts",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Description: "Name of the setting",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"label": {
+						Description: "Label of the setting in the Segment app",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"type": {
+						Description: "Type of the setting's value",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"required": {
+						Description: "Flag for whether or not the setting is required",
+						Type:        schema.TypeBool,
+						Computed:    true,
+					},
+					"default_value": {
+						Description: "Default value of the setting as a JSON encoded string, empty when there's no default",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"description": {
+						Description: "Description of the setting",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSourceCatalogRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var metadata *segment.SourceMetadata
+	var err error
+	if metadataID := d.Get("metadata_id").(string); metadataID != "" {
+		metadata, err = c.GetSourceMetadata(metadataID)
+	} else {
+		metadata, err = c.GetSourceMetadataFromCatalog(d.Get("slug").(string))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return setCatalogMetadata(d, metadata.ID, metadata.Slug, metadata.Name, metadata.Description, metadata.Categories, metadata.Logos, metadata.Options)
+}
+
+func dataSourceDestinationCatalogRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var metadata *segment.DestinationMetadata
+	var err error
+	if metadataID := d.Get("metadata_id").(string); metadataID != "" {
+		metadata, err = c.GetDestinationMetadata(metadataID)
+	} else {
+		metadata, err = c.GetDestinationMetadataFromCatalog(d.Get("slug").(string))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return setCatalogMetadata(d, metadata.ID, metadata.Slug, metadata.Name, metadata.Description, metadata.Categories, metadata.Logos, metadata.Options)
+}
+
+func dataSourceWarehouseCatalogRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var metadata *segment.WarehouseMetadata
+	var err error
+	if metadataID := d.Get("metadata_id").(string); metadataID != "" {
+		metadata, err = c.GetWarehouseMetadata(metadataID)
+	} else {
+		metadata, err = c.GetWarehouseMetadataFromCatalog(d.Get("slug").(string))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return setCatalogMetadata(d, metadata.ID, metadata.Slug, metadata.Name, metadata.Description, nil, metadata.Logos, metadata.Options)
+}
+
+func setCatalogMetadata(d *schema.ResourceData, id, slug, name, description string, categories []string, logos segment.Logo, options []segment.IntegrationOption) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	flatOptions := make([]interface{}, 0, len(options))
+	for _, option := range options {
+		defaultValue := ""
+		if option.DefaultValue != nil {
+			v, err := json.Marshal(option.DefaultValue)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			defaultValue = string(v)
+		}
+		flatOptions = append(flatOptions, map[string]interface{}{
+			"name":          option.Name,
+			"label":         option.Label,
+			"type":          option.Type,
+			"required":      option.Required,
+			"default_value": defaultValue,
+			"description":   option.Description,
+		})
+	}
+
+	if err := d.Set("slug", slug); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("metadata_id", id); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("description", description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("categories", categories); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("logos", []interface{}{map[string]interface{}{
+		"default": logos.Default,
+		"mark":    logos.Mark,
+		"alt":     logos.Alt,
+	}}); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("options", flatOptions); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id)
+
+	return diags
+}
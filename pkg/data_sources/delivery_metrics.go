@@ -0,0 +1,189 @@
+package data_sources
+
+import (
+	"context"
+	"time"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDestinationDeliveryMetrics() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDestinationDeliveryMetricsRead,
+
+		Schema: map[string]*schema.Schema{
+			"destination_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Identifier of the destination",
+			},
+			"source_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Identifier of the source sending events, defaults to the source the destination is connected to",
+			},
+			"start_time": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Start of the time window as an RFC 3339 timestamp, defaults to a day before `end_time`",
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"end_time": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "End of the time window as an RFC 3339 timestamp, defaults to now",
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"granularity": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Granularity Segment aggregates the metrics at, from a list",
+				ValidateFunc: validation.StringInSlice(segment.DeliveryMetricsGranularities, false),
+			},
+			"successes": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of events delivered successfully",
+			},
+			"failures": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of events that failed to be delivered",
+			},
+			"failure_reasons": &schema.Schema{
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Number of failed events by reason",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"retries": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of delivery attempts that were retried",
+			},
+			"metrics": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Every metric returned by Segment, including the ones summarised above",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "Name of the metric",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"total": {
+							Description: "Total over the time window",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"breakdown": {
+							Description: "Total broken down by reason",
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDestinationDeliveryMetricsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	destinationID := d.Get("destination_id").(string)
+	granularity := d.Get("granularity").(string)
+	// Taken before the defaults are filled in, so the ID stays the same from
+	// one read to the next
+	id := queryID(destinationID, d.Get("source_id"), d.Get("start_time"), d.Get("end_time"), granularity)
+
+	sourceID := d.Get("source_id").(string)
+	if sourceID == "" {
+		destination, err := c.GetDestination(destinationID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		sourceID = destination.SourceID
+	}
+
+	endTime := time.Now().UTC()
+	if v := d.Get("end_time").(string); v != "" {
+		endTime, _ = time.Parse(time.RFC3339, v)
+	}
+	startTime := endTime.Add(-24 * time.Hour)
+	if v := d.Get("start_time").(string); v != "" {
+		startTime, _ = time.Parse(time.RFC3339, v)
+	}
+	if !startTime.Before(endTime) {
+		return diag.Errorf("start_time %s must be before end_time %s", startTime.Format(time.RFC3339), endTime.Format(time.RFC3339))
+	}
+
+	summary, err := c.GetDestinationDeliveryMetrics(destinationID, segment.DeliveryMetricsQuery{
+		SourceID:    sourceID,
+		StartTime:   startTime.Format(time.RFC3339),
+		EndTime:     endTime.Format(time.RFC3339),
+		Granularity: granularity,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	totals := make(map[string]int, len(summary.Metrics))
+	breakdowns := make(map[string]map[string]interface{}, len(summary.Metrics))
+	flatMetrics := make([]interface{}, 0, len(summary.Metrics))
+	for _, metric := range summary.Metrics {
+		breakdown := make(map[string]interface{}, len(metric.Breakdown))
+		for _, b := range metric.Breakdown {
+			breakdown[b.MetricName] = b.Value
+		}
+		totals[metric.MetricName] = metric.Total
+		breakdowns[metric.MetricName] = breakdown
+		flatMetrics = append(flatMetrics, map[string]interface{}{
+			"name":      metric.MetricName,
+			"total":     metric.Total,
+			"breakdown": breakdown,
+		})
+	}
+
+	if err := d.Set("source_id", sourceID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("start_time", startTime.Format(time.RFC3339)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("end_time", endTime.Format(time.RFC3339)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("successes", totals["successes"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("failures", totals["failures"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("failure_reasons", breakdowns["failures"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("retries", totals["retries"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("metrics", flatMetrics); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id)
+
+	return diags
+}
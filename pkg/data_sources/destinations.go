@@ -1 +1,225 @@
 package data_sources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceDestination() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDestinationRead,
+
+		Schema: map[string]*schema.Schema{
+			"destination_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Identifier of the destination to look up",
+				ExactlyOneOf: []string{"destination_id", "name"},
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Name of the destination to look up, fails if more than one destination has the name",
+				ExactlyOneOf: []string{"destination_id", "name"},
+			},
+			"source_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Identifier of the source the destination is connected to, narrows down a look up by name",
+			},
+			"enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Flag for whether or not the destination is enabled",
+			},
+			"destination_slug": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Slug for the type of destination",
+			},
+			"metadata_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identifier of the destination in the catalog",
+			},
+			"settings": &schema.Schema{
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Settings of the destination, with every value as a string",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func DataSourceDestinations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDestinationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"destination_slug": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list destinations of this type, i.e. `google-analytics`",
+			},
+			"enabled":    enabledFilterSchema(),
+			"name_regex": nameRegexSchema(),
+			"source_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list destinations connected to this source",
+			},
+			"ids": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Identifiers of the matching destinations",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"destinations": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Matching destinations",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "Identifier of the destination",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the destination",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"enabled": {
+							Description: "Flag for whether or not the destination is enabled",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"destination_slug": {
+							Description: "Slug for the type of destination",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"source_id": {
+							Description: "Identifier of the source the destination is connected to",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDestinationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	destinationID := d.Get("destination_id").(string)
+	name := d.Get("name").(string)
+
+	var destination *segment.Destination
+	var err error
+	if destinationID != "" {
+		destination, err = c.GetDestination(destinationID)
+	} else {
+		destination, err = c.GetDestinationByName(d.Get("source_id").(string), name)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	settings := make(map[string]interface{}, len(destination.Settings))
+	for k, v := range destination.Settings {
+		switch v := v.(type) {
+		case string:
+			settings[k] = v
+		case nil:
+		default:
+			settings[k] = fmt.Sprintf("%v", v)
+		}
+	}
+
+	if err := d.Set("destination_id", destination.ID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", destination.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("source_id", destination.SourceID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("enabled", destination.Enabled); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("destination_slug", destination.Metadata.Slug); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("metadata_id", destination.Metadata.ID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("settings", settings); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(*destination.ID)
+
+	return diags
+}
+
+func dataSourceDestinationsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	filter := expandListFilter(d, "destination_slug")
+	sourceID := d.Get("source_id").(string)
+
+	destinations, err := c.ListDestinations()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := make([]string, 0, len(destinations))
+	flatDestinations := make([]interface{}, 0, len(destinations))
+	for _, destination := range destinations {
+		if !filter.matches(destination.Metadata.Slug, destination.Enabled, destination.Name) {
+			continue
+		}
+		if sourceID != "" && destination.SourceID != sourceID {
+			continue
+		}
+
+		ids = append(ids, *destination.ID)
+		flatDestinations = append(flatDestinations, map[string]interface{}{
+			"id":               *destination.ID,
+			"name":             destination.Name,
+			"enabled":          destination.Enabled,
+			"destination_slug": destination.Metadata.Slug,
+			"source_id":        destination.SourceID,
+		})
+	}
+
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("destinations", flatDestinations); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(filter.id(sourceID))
+
+	return diags
+}
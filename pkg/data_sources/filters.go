@@ -0,0 +1,70 @@
+package data_sources
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// listFilter holds the filters shared by the list data sources, an object
+// has to match all of them to be listed.
+type listFilter struct {
+	catalogSlug string
+	enabled     *bool
+	nameRegex   *regexp.Regexp
+}
+
+func nameRegexSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "Only list objects whose name matches this regular expression",
+		ValidateFunc: validation.StringIsValidRegExp,
+	}
+}
+
+func enabledFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Only list objects that are enabled, or disabled when false",
+	}
+}
+
+func expandListFilter(d *schema.ResourceData, catalogSlugKey string) listFilter {
+	filter := listFilter{
+		catalogSlug: d.Get(catalogSlugKey).(string),
+	}
+	// A bool that isn't set reads as false, so the configuration is checked
+	if enabled := d.GetRawConfig().GetAttr("enabled"); !enabled.IsNull() {
+		e := enabled.True()
+		filter.enabled = &e
+	}
+	if nameRegex := d.Get("name_regex").(string); nameRegex != "" {
+		filter.nameRegex = regexp.MustCompile(nameRegex)
+	}
+	return filter
+}
+
+func (f listFilter) matches(catalogSlug string, enabled bool, name string) bool {
+	if f.catalogSlug != "" && f.catalogSlug != catalogSlug {
+		return false
+	}
+	if f.enabled != nil && *f.enabled != enabled {
+		return false
+	}
+	if f.nameRegex != nil && !f.nameRegex.MatchString(name) {
+		return false
+	}
+	return true
+}
+
+// id identifies the filter and the other arguments of the query, see queryID.
+func (f listFilter) id(arguments ...interface{}) string {
+	nameRegex := ""
+	if f.nameRegex != nil {
+		nameRegex = f.nameRegex.String()
+	}
+	return queryID(append([]interface{}{f.catalogSlug, f.enabled, nameRegex}, arguments...)...)
+}
@@ -0,0 +1,79 @@
+package data_sources
+
+import (
+	"context"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceFunctionVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFunctionVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"function_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Identifier of the function",
+			},
+			"versions": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Versions of the function, newest first",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "Identifier of the version",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"created_at": {
+							Description: "Time the version was created",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"created_by": {
+							Description: "Identifier of the user who created the version",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFunctionVersionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	functionID := d.Get("function_id").(string)
+
+	versions, err := c.ListFunctionVersions(functionID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := segment.SortFunctionVersions(versions); err != nil {
+		return diag.FromErr(err)
+	}
+
+	flatVersions := make([]interface{}, 0, len(versions))
+	for _, version := range versions {
+		flatVersions = append(flatVersions, map[string]interface{}{
+			"id":         version.ID,
+			"created_at": version.CreatedAt,
+			"created_by": version.CreatedBy,
+		})
+	}
+
+	if err := d.Set("versions", flatVersions); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(functionID)
+
+	return diags
+}
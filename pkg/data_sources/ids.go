@@ -0,0 +1,14 @@
+package data_sources
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+)
+
+// queryID identifies a list data source by the arguments of its query, so its
+// ID stays the same from one read to the next.
+func queryID(arguments ...interface{}) string {
+	encoded, _ := json.Marshal(arguments)
+	return fmt.Sprintf("%x", sha256.Sum256(encoded))
+}
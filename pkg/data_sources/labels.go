@@ -0,0 +1,79 @@
+package data_sources
+
+import (
+	"context"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceLabels() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLabelsRead,
+
+		Schema: map[string]*schema.Schema{
+			"key": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return labels with this key",
+			},
+			"labels": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Labels in the workspace",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Description: "Key of the label",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"value": {
+							Description: "Value of the label",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "Description of the label",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLabelsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	key := d.Get("key").(string)
+
+	labels, err := c.ListLabels()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	flatLabels := make([]interface{}, 0, len(labels))
+	for _, label := range labels {
+		if key != "" && label.Key != key {
+			continue
+		}
+		flatLabels = append(flatLabels, map[string]interface{}{
+			"key":         label.Key,
+			"value":       label.Value,
+			"description": label.Description,
+		})
+	}
+
+	if err := d.Set("labels", flatLabels); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(queryID(key))
+
+	return diags
+}
@@ -0,0 +1,101 @@
+package data_sources
+
+import (
+	"context"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceRegulations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRegulationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"source_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list regulations of this source, lists the regulations of the whole workspace when not set",
+			},
+			"status": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only list regulations with this status, from a list",
+				ValidateFunc: validation.StringInSlice(segment.RegulationStatuses, false),
+			},
+			"regulation_types": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Only list regulations of these types",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(segment.RegulationTypes, false),
+				},
+			},
+			"regulations": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Regulations matching the filters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "Identifier of the regulation",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"status": {
+							Description: "Overall status of the regulation",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"created_at": {
+							Description: "Time the regulation was submitted",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"finished_at": {
+							Description: "Time the regulation finished, empty while it's pending",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRegulationsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	var regulationTypes []string
+	for _, regulationType := range d.Get("regulation_types").([]interface{}) {
+		regulationTypes = append(regulationTypes, regulationType.(string))
+	}
+
+	regulations, err := c.ListRegulations(d.Get("source_id").(string), d.Get("status").(string), regulationTypes)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	flatRegulations := make([]interface{}, 0, len(regulations))
+	for _, regulation := range regulations {
+		flatRegulations = append(flatRegulations, map[string]interface{}{
+			"id":          regulation.ID,
+			"status":      regulation.OverallStatus,
+			"created_at":  regulation.CreatedAt,
+			"finished_at": regulation.FinishedAt,
+		})
+	}
+
+	if err := d.Set("regulations", flatRegulations); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(queryID(d.Get("source_id"), d.Get("status"), regulationTypes))
+
+	return diags
+}
@@ -0,0 +1,96 @@
+package data_sources
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceRoles() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRolesRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the role with this name, i.e. \"Workspace Owner\", compared case insensitively",
+			},
+			"roles": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Roles available in the workspace",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "Identifier of the role",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the role",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "Description of the role",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"ids": &schema.Schema{
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Map of role name to role identifier",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRolesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	name := d.Get("name").(string)
+
+	roles, err := c.ListRoles()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	flatRoles := make([]interface{}, 0, len(roles))
+	ids := make(map[string]interface{}, len(roles))
+	for _, role := range roles {
+		if name != "" && !strings.EqualFold(role.Name, name) {
+			continue
+		}
+		flatRoles = append(flatRoles, map[string]interface{}{
+			"id":          role.ID,
+			"name":        role.Name,
+			"description": role.Description,
+		})
+		ids[role.Name] = role.ID
+	}
+	if name != "" && len(flatRoles) == 0 {
+		return diag.Errorf("Role %s not found", name)
+	}
+
+	if err := d.Set("roles", flatRoles); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(queryID(name))
+
+	return diags
+}
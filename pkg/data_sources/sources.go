@@ -1 +1,288 @@
 package data_sources
+
+import (
+	"context"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/resources"
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"source_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Identifier of the source to look up",
+				ExactlyOneOf: []string{"source_id", "slug", "name"},
+			},
+			"slug": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Slug of the source to look up",
+				ExactlyOneOf: []string{"source_id", "slug", "name"},
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Name of the source to look up, fails if more than one source has the name",
+				ExactlyOneOf: []string{"source_id", "slug", "name"},
+			},
+			"enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Flag for whether or not the source is enabled",
+			},
+			"source_slug": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Slug for the type of source",
+			},
+			"metadata_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identifier of the source in the catalog",
+			},
+			"write_keys": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Write keys used to send data to the source",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"labels": &schema.Schema{
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Labels of the source, keyed by label key",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"settings": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Settings of the source",
+				Elem: &schema.Resource{
+					Schema: computedSchema(resources.SourceSettingsSchema()),
+				},
+			},
+		},
+	}
+}
+
+func DataSourceSources() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"source_slug": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list sources of this type, i.e. `javascript`",
+			},
+			"enabled":    enabledFilterSchema(),
+			"name_regex": nameRegexSchema(),
+			"labels": &schema.Schema{
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Only list sources with all of these labels",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ids": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Identifiers of the matching sources",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"sources": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Matching sources",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "Identifier of the source",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"slug": {
+							Description: "Slug of the source",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the source",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"enabled": {
+							Description: "Flag for whether or not the source is enabled",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"source_slug": {
+							Description: "Slug for the type of source",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"labels": {
+							Description: "Labels of the source, keyed by label key",
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// computedSchema copies a resource schema with every attribute made
+// read-only, so data sources can share the schema of resources.
+func computedSchema(resourceSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	computed := make(map[string]*schema.Schema, len(resourceSchema))
+	for k, v := range resourceSchema {
+		s := &schema.Schema{
+			Type:        v.Type,
+			Description: v.Description,
+			Sensitive:   v.Sensitive,
+			Computed:    true,
+			Elem:        v.Elem,
+		}
+		if elem, ok := v.Elem.(*schema.Resource); ok {
+			s.Elem = &schema.Resource{
+				Schema: computedSchema(elem.Schema),
+			}
+		}
+		computed[k] = s
+	}
+	return computed
+}
+
+func dataSourceSourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	sourceID := d.Get("source_id").(string)
+	slug := d.Get("slug").(string)
+	name := d.Get("name").(string)
+
+	var source *segment.Source
+	var err error
+	if sourceID != "" {
+		source, err = c.GetSource(sourceID)
+	} else if slug != "" {
+		source, err = c.GetSourceBySlug(slug)
+	} else {
+		source, err = c.GetSourceByName(name)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("source_id", source.ID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("slug", source.Slug); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", source.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("enabled", source.Enabled); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("source_slug", source.Metadata.Slug); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("metadata_id", source.Metadata.ID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("write_keys", source.WriteKeys); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("labels", flattenLabels(source.Labels)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("settings", resources.FlattenSourceSettings(source.Settings)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(*source.ID)
+
+	return diags
+}
+
+func dataSourceSourcesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	filter := expandListFilter(d, "source_slug")
+	labels := d.Get("labels").(map[string]interface{})
+
+	sources, err := c.ListSources()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := make([]string, 0, len(sources))
+	flatSources := make([]interface{}, 0, len(sources))
+	for _, source := range sources {
+		if !filter.matches(source.Metadata.Slug, source.Enabled, source.Name) {
+			continue
+		}
+		sourceLabels := flattenLabels(source.Labels)
+		hasLabels := true
+		for k, v := range labels {
+			hasLabels = hasLabels && sourceLabels[k] == v
+		}
+		if !hasLabels {
+			continue
+		}
+
+		ids = append(ids, *source.ID)
+		flatSources = append(flatSources, map[string]interface{}{
+			"id":          *source.ID,
+			"slug":        source.Slug,
+			"name":        source.Name,
+			"enabled":     source.Enabled,
+			"source_slug": source.Metadata.Slug,
+			"labels":      sourceLabels,
+		})
+	}
+
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("sources", flatSources); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(filter.id(labels))
+
+	return diags
+}
+
+func flattenLabels(labels []segment.Label) map[string]interface{} {
+	flatLabels := make(map[string]interface{}, len(labels))
+	for _, label := range labels {
+		flatLabels[label.Key] = label.Value
+	}
+	return flatLabels
+}
@@ -0,0 +1,70 @@
+package data_sources
+
+import (
+	"context"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceSpace() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSpaceRead,
+
+		Schema: map[string]*schema.Schema{
+			"space_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Identifier of the space to look up",
+				ExactlyOneOf: []string{"space_id", "name"},
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Name of the space to look up",
+				ExactlyOneOf: []string{"space_id", "name"},
+			},
+			"slug": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Slug of the space",
+			},
+		},
+	}
+}
+
+func dataSourceSpaceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	spaceID := d.Get("space_id").(string)
+	name := d.Get("name").(string)
+
+	var space *segment.Space
+	var err error
+	if spaceID != "" {
+		space, err = c.GetSpace(spaceID)
+	} else {
+		space, err = c.GetSpaceByName(name)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("space_id", space.ID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", space.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("slug", space.Slug); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(space.ID)
+
+	return diags
+}
@@ -0,0 +1,221 @@
+package data_sources
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/jsonschema"
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	RuleKeySources = []string{
+		"title",
+		"filename",
+	}
+
+	// KeylessRuleTypes apply to every call of their type, so they have no key
+	KeylessRuleTypes = []string{
+		"GROUP",
+		"IDENTIFY",
+	}
+)
+
+func DataSourceTrackingPlanRuleFiles() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTrackingPlanRuleFilesRead,
+
+		Schema: map[string]*schema.Schema{
+			"pattern": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Glob matching the JSON Schema files to load, i.e. \"${path.module}/schemas/*.json\"",
+			},
+			"rule_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "TRACK",
+				Description:  "Type of rule to create for every file, from a list",
+				ValidateFunc: validation.StringInSlice(segment.TrackingPlanRuleTypes, false),
+			},
+			"key_from": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "title",
+				Description:  "Where to take the rule key (event name) from, either the schema `title` or the filename without extension. Files without a title fall back to the filename. IDENTIFY and GROUP rules have no key",
+				ValidateFunc: validation.StringInSlice(RuleKeySources, false),
+			},
+			"version": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+				Description: "Version given to every rule",
+			},
+			"rules": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Rules built from the files, in the same shape as the `rule` blocks of `segment_tracking_plan_rules`",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Description: "Type of the rule",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"key": {
+							Description: "Key of the rule, i.e. the event name for TRACK rules",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"version": {
+							Description: "Version of the rule",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"json_schema": {
+							Description: "JSON Schema for the rule, as a normalised JSON encoded string",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"file": {
+							Description: "File the rule was loaded from",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"payload": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Public API request body containing every rule",
+			},
+		},
+	}
+}
+
+func dataSourceTrackingPlanRuleFilesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	pattern := d.Get("pattern").(string)
+	ruleType := d.Get("rule_type").(string)
+	keyFrom := d.Get("key_from").(string)
+	version := d.Get("version").(int)
+
+	keyless := false
+	for _, keylessType := range KeylessRuleTypes {
+		if ruleType == keylessType {
+			keyless = true
+		}
+	}
+
+	files, err := filepath.Glob(pattern)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(files) == 0 {
+		return diag.Errorf("No files match %s", pattern)
+	}
+	sort.Strings(files)
+
+	rules := make([]segment.TrackingPlanRule, 0, len(files))
+	flatRules := make([]interface{}, 0, len(files))
+	keys := make(map[string]string, len(files))
+
+	for _, file := range files {
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		var document interface{}
+		if err := json.Unmarshal(content, &document); err != nil {
+			diags = append(diags, invalidSchemaFile(file, err))
+			continue
+		}
+		if err := jsonschema.ValidateDraft07(document); err != nil {
+			diags = append(diags, invalidSchemaFile(file, err))
+			continue
+		}
+		jsonSchema, ok := document.(map[string]interface{})
+		if !ok {
+			diags = append(diags, invalidSchemaFile(file, fmt.Errorf("schema must be an object")))
+			continue
+		}
+
+		key := ""
+		if !keyless {
+			key = strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+			if title, ok := jsonSchema["title"].(string); ok && title != "" && keyFrom == "title" {
+				key = title
+			}
+		}
+		if other, ok := keys[key]; ok {
+			detail := fmt.Sprintf("%s and %s both define the %s rule %q", other, file, ruleType, key)
+			if keyless {
+				detail = fmt.Sprintf("%s and %s both define the %s rule, there can only be one", other, file, ruleType)
+			}
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Duplicate tracking plan rule",
+				Detail:   detail,
+			})
+			continue
+		}
+		keys[key] = file
+
+		normalizedSchema, err := json.Marshal(jsonSchema)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		rules = append(rules, segment.TrackingPlanRule{
+			Type:       ruleType,
+			Key:        key,
+			Version:    version,
+			JSONSchema: jsonSchema,
+		})
+		flatRules = append(flatRules, map[string]interface{}{
+			"type":        ruleType,
+			"key":         key,
+			"version":     version,
+			"json_schema": string(normalizedSchema),
+			"file":        file,
+		})
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	payload, err := json.Marshal(segment.TrackingPlanRulesRequest{Rules: rules})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("rules", flatRules); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("payload", string(payload)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256(payload)))
+
+	return diags
+}
+
+func invalidSchemaFile(file string, err error) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  "Invalid JSON Schema",
+		Detail:   fmt.Sprintf("%s: %s", file, err),
+	}
+}
@@ -0,0 +1,338 @@
+package data_sources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const usagePeriodFormat = "2006-01-02"
+
+func DataSourceUsage() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceUsageRead,
+
+		Schema: map[string]*schema.Schema{
+			"period": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Start date of the usage period as YYYY-MM-DD, defaults to the first day of the current month",
+				ValidateFunc: validateUsagePeriod,
+			},
+			"source_ids": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Only report per source usage for these sources, reports every source when not set",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"total_api_calls": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "API calls made by the workspace over the period",
+			},
+			"api_calls": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "API calls made by the workspace each day",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"timestamp": {
+							Description: "Day of the usage",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"api_calls": {
+							Description: "API calls made on the day",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"mtu": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Monthly tracked users of the workspace each day, counted from the start of the period",
+				Elem: &schema.Resource{
+					Schema: mtuUsageSchema(map[string]*schema.Schema{
+						"timestamp": {
+							Description: "Day of the usage",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					}),
+				},
+			},
+			"api_calls_by_source": &schema.Schema{
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "API calls made over the period, keyed by source ID",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"sources": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Usage of each source over the period",
+				Elem: &schema.Resource{
+					Schema: mtuUsageSchema(map[string]*schema.Schema{
+						"source_id": {
+							Description: "Identifier of the source",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"api_calls": {
+							Description: "API calls made by the source over the period",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					}),
+				},
+			},
+			"daily_by_source": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Usage of each source each day, ordered by source and day",
+				Elem: &schema.Resource{
+					Schema: mtuUsageSchema(map[string]*schema.Schema{
+						"source_id": {
+							Description: "Identifier of the source",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"timestamp": {
+							Description: "Day of the usage",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"api_calls": {
+							Description: "API calls made by the source on the day",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					}),
+				},
+			},
+		},
+	}
+}
+
+// mtuUsageSchema is the schema of the monthly tracked user counts, extended
+// with the fields identifying the usage.
+func mtuUsageSchema(extra map[string]*schema.Schema) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"anonymous": {
+			Description: "Users only ever seen with an anonymous ID",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"anonymous_identified": {
+			Description: "Users seen with an anonymous ID before being identified",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"identified": {
+			Description: "Users seen with a user ID",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"never_identified": {
+			Description: "Users never seen with a user ID",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+	}
+	for k, v := range extra {
+		s[k] = v
+	}
+	return s
+}
+
+func validateUsagePeriod(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if _, err := time.Parse(usagePeriodFormat, v); err != nil {
+		return nil, []error{fmt.Errorf("expected %s to be a date formatted as YYYY-MM-DD, got %s", k, v)}
+	}
+	return nil, nil
+}
+
+func dataSourceUsageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	period := d.Get("period").(string)
+	if period == "" {
+		now := time.Now().UTC()
+		period = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).Format(usagePeriodFormat)
+	}
+
+	var sourceIDs map[string]bool
+	if ids := d.Get("source_ids").([]interface{}); len(ids) > 0 {
+		sourceIDs = make(map[string]bool, len(ids))
+		for _, id := range ids {
+			sourceIDs[id.(string)] = true
+		}
+	}
+
+	apiCalls, err := c.ListAPICallsUsage(period)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	mtu, err := c.ListMTUUsage(period)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	sourceAPICalls, err := c.ListSourceAPICallsUsage(period)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	sourceMTU, err := c.ListSourceMTUUsage(period)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	totalAPICalls := 0
+	flatAPICalls := make([]interface{}, 0, len(apiCalls))
+	for _, usage := range apiCalls {
+		totalAPICalls += usage.APICalls
+		flatAPICalls = append(flatAPICalls, map[string]interface{}{
+			"timestamp": usage.Timestamp,
+			"api_calls": usage.APICalls,
+		})
+	}
+
+	flatMTU := make([]interface{}, 0, len(mtu))
+	for _, usage := range mtu {
+		flatUsage := flattenMTUUsage(usage)
+		flatUsage["timestamp"] = usage.Timestamp
+		flatMTU = append(flatMTU, flatUsage)
+	}
+
+	// API calls add up over the period, while MTU counts already cover the
+	// period so far and only the latest day is kept
+	apiCallsBySource := make(map[string]interface{})
+	for _, usage := range sourceAPICalls {
+		if sourceIDs != nil && !sourceIDs[usage.SourceID] {
+			continue
+		}
+		total, _ := apiCallsBySource[usage.SourceID].(int)
+		apiCallsBySource[usage.SourceID] = total + usage.APICalls
+	}
+	latestMTUBySource := make(map[string]segment.DailyMTUUsage)
+	for _, usage := range sourceMTU {
+		if sourceIDs != nil && !sourceIDs[usage.SourceID] {
+			continue
+		}
+		if latest, ok := latestMTUBySource[usage.SourceID]; !ok || usage.Timestamp > latest.Timestamp {
+			latestMTUBySource[usage.SourceID] = usage
+		}
+	}
+
+	type sourceDay struct {
+		sourceID  string
+		timestamp string
+	}
+	dailyAPICalls := make(map[sourceDay]int)
+	dailyMTU := make(map[sourceDay]segment.DailyMTUUsage)
+	var days []sourceDay
+	for _, usage := range sourceAPICalls {
+		day := sourceDay{usage.SourceID, usage.Timestamp}
+		if _, ok := dailyAPICalls[day]; !ok {
+			days = append(days, day)
+		}
+		dailyAPICalls[day] += usage.APICalls
+	}
+	for _, usage := range sourceMTU {
+		day := sourceDay{usage.SourceID, usage.Timestamp}
+		if _, ok := dailyAPICalls[day]; !ok {
+			if _, ok := dailyMTU[day]; !ok {
+				days = append(days, day)
+			}
+		}
+		dailyMTU[day] = usage
+	}
+	sort.Slice(days, func(i, j int) bool {
+		if days[i].sourceID != days[j].sourceID {
+			return days[i].sourceID < days[j].sourceID
+		}
+		return days[i].timestamp < days[j].timestamp
+	})
+
+	flatDaily := make([]interface{}, 0, len(days))
+	for _, day := range days {
+		if sourceIDs != nil && !sourceIDs[day.sourceID] {
+			continue
+		}
+		flatDay := flattenMTUUsage(dailyMTU[day])
+		flatDay["source_id"] = day.sourceID
+		flatDay["timestamp"] = day.timestamp
+		flatDay["api_calls"] = dailyAPICalls[day]
+		flatDaily = append(flatDaily, flatDay)
+	}
+
+	var usageSourceIDs []string
+	for sourceID := range apiCallsBySource {
+		usageSourceIDs = append(usageSourceIDs, sourceID)
+	}
+	for sourceID := range latestMTUBySource {
+		if _, ok := apiCallsBySource[sourceID]; !ok {
+			usageSourceIDs = append(usageSourceIDs, sourceID)
+		}
+	}
+	sort.Strings(usageSourceIDs)
+
+	flatSources := make([]interface{}, 0, len(usageSourceIDs))
+	for _, sourceID := range usageSourceIDs {
+		flatSource := flattenMTUUsage(latestMTUBySource[sourceID])
+		flatSource["source_id"] = sourceID
+		flatSource["api_calls"], _ = apiCallsBySource[sourceID].(int)
+		flatSources = append(flatSources, flatSource)
+	}
+
+	if err := d.Set("period", period); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("total_api_calls", totalAPICalls); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("api_calls", flatAPICalls); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("mtu", flatMTU); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("api_calls_by_source", apiCallsBySource); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("sources", flatSources); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("daily_by_source", flatDaily); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(queryID(period, d.Get("source_ids")))
+
+	return diags
+}
+
+func flattenMTUUsage(usage segment.DailyMTUUsage) map[string]interface{} {
+	return map[string]interface{}{
+		"anonymous":            usage.Anonymous,
+		"anonymous_identified": usage.AnonymousIdentified,
+		"identified":           usage.Identified,
+		"never_identified":     usage.NeverIdentified,
+	}
+}
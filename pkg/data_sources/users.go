@@ -0,0 +1,236 @@
+package data_sources
+
+import (
+	"context"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceUser() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceUserRead,
+
+		Schema: map[string]*schema.Schema{
+			"user_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Identifier of the user to look up",
+				ExactlyOneOf: []string{"user_id", "email"},
+			},
+			"email": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Email address of the user to look up",
+				ExactlyOneOf: []string{"user_id", "email"},
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the user",
+			},
+			"permissions": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Permissions granted directly to the user",
+				Elem: &schema.Resource{
+					Schema: permissionSchema(),
+				},
+			},
+		},
+	}
+}
+
+func DataSourceUsers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceUsersRead,
+
+		Schema: map[string]*schema.Schema{
+			"users": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Users in the workspace",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "Identifier of the user",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the user",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"email": {
+							Description: "Email address of the user",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"pending_invites": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Email addresses with an invite to the workspace that hasn't been accepted",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func permissionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"role_id": {
+			Description: "Identifier of the role",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"role_name": {
+			Description: "Name of the role",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"resource": {
+			Description: "Resources the role is granted on",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Description: "Identifier of the workspace, source or space",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"type": {
+						Description: "Type of the resource",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"label": {
+						Description: "Labels the grant is restricted to",
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"key": {
+									Description: "Key of the label",
+									Type:        schema.TypeString,
+									Computed:    true,
+								},
+								"value": {
+									Description: "Value of the label",
+									Type:        schema.TypeString,
+									Computed:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	userID := d.Get("user_id").(string)
+	email := d.Get("email").(string)
+
+	var user *segment.User
+	var err error
+	if userID != "" {
+		user, err = c.GetUser(userID)
+	} else {
+		user, err = c.GetUserByEmail(email)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("user_id", user.ID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("email", user.Email); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", user.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("permissions", flattenPermissions(user.Permissions)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(user.ID)
+
+	return diags
+}
+
+func dataSourceUsersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	users, err := c.ListUsers()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	invites, err := c.ListInvites()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	flatUsers := make([]interface{}, 0, len(users))
+	for _, user := range users {
+		flatUsers = append(flatUsers, map[string]interface{}{
+			"id":    user.ID,
+			"name":  user.Name,
+			"email": user.Email,
+		})
+	}
+
+	if err := d.Set("users", flatUsers); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("pending_invites", invites); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(queryID())
+
+	return diags
+}
+
+func flattenPermissions(permissions []segment.Permission) []interface{} {
+	flatPermissions := make([]interface{}, 0, len(permissions))
+	for _, permission := range permissions {
+		resources := make([]interface{}, 0, len(permission.Resources))
+		for _, resource := range permission.Resources {
+			labels := make([]interface{}, 0, len(resource.Labels))
+			for _, label := range resource.Labels {
+				labels = append(labels, map[string]interface{}{
+					"key":   label.Key,
+					"value": label.Value,
+				})
+			}
+			resources = append(resources, map[string]interface{}{
+				"id":    resource.ID,
+				"type":  resource.Type,
+				"label": labels,
+			})
+		}
+		flatPermissions = append(flatPermissions, map[string]interface{}{
+			"role_id":   permission.RoleID,
+			"role_name": permission.RoleName,
+			"resource":  resources,
+		})
+	}
+	return flatPermissions
+}
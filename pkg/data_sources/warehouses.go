@@ -1 +1,225 @@
 package data_sources
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceWarehouse() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceWarehouseRead,
+
+		Schema: map[string]*schema.Schema{
+			"warehouse_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Identifier of the warehouse to look up",
+				ExactlyOneOf: []string{"warehouse_id", "name"},
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Name of the warehouse to look up, fails if more than one warehouse has the name",
+				ExactlyOneOf: []string{"warehouse_id", "name"},
+			},
+			"enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Flag for whether or not the warehouse is enabled",
+			},
+			"warehouse_slug": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Slug for the type of warehouse",
+			},
+			"settings": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Settings of the warehouse, without its password",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hostname": {
+							Description: "Hostname for the warehouse",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"database": {
+							Description: "Database for the warehouse",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"port": {
+							Description: "Port for the warehouse",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"username": {
+							Description: "Username for the warehouse",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DataSourceWarehouses() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceWarehousesRead,
+
+		Schema: map[string]*schema.Schema{
+			"warehouse_slug": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list warehouses of this type, i.e. `snowflake`",
+			},
+			"enabled":    enabledFilterSchema(),
+			"name_regex": nameRegexSchema(),
+			"source_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list warehouses this source is connected to",
+			},
+			"ids": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Identifiers of the matching warehouses",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"warehouses": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Matching warehouses",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "Identifier of the warehouse",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the warehouse",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"enabled": {
+							Description: "Flag for whether or not the warehouse is enabled",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"warehouse_slug": {
+							Description: "Slug for the type of warehouse",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceWarehouseRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	warehouseID := d.Get("warehouse_id").(string)
+	name := d.Get("name").(string)
+
+	var warehouse *segment.Warehouse
+	var err error
+	if warehouseID != "" {
+		warehouse, err = c.GetWarehouse(warehouseID)
+	} else {
+		warehouse, err = c.GetWarehouseByName(name)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	port, _ := strconv.Atoi(warehouse.Settings.Port)
+	settings := []interface{}{
+		map[string]interface{}{
+			"hostname": warehouse.Settings.Hostname,
+			"database": warehouse.Settings.Database,
+			"port":     port,
+			"username": warehouse.Settings.Username,
+		},
+	}
+
+	if err := d.Set("warehouse_id", warehouse.ID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", warehouse.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("enabled", warehouse.Enabled); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("warehouse_slug", warehouse.Metadata.Slug); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("settings", settings); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(*warehouse.ID)
+
+	return diags
+}
+
+func dataSourceWarehousesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	filter := expandListFilter(d, "warehouse_slug")
+	sourceID := d.Get("source_id").(string)
+
+	var warehouses []segment.Warehouse
+	var err error
+	if sourceID != "" {
+		warehouses, err = c.ListSourceConnectedWarehouses(sourceID)
+	} else {
+		warehouses, err = c.ListWarehouses()
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := make([]string, 0, len(warehouses))
+	flatWarehouses := make([]interface{}, 0, len(warehouses))
+	for _, warehouse := range warehouses {
+		if !filter.matches(warehouse.Metadata.Slug, warehouse.Enabled, warehouse.Name) {
+			continue
+		}
+
+		ids = append(ids, *warehouse.ID)
+		flatWarehouses = append(flatWarehouses, map[string]interface{}{
+			"id":             *warehouse.ID,
+			"name":           warehouse.Name,
+			"enabled":        warehouse.Enabled,
+			"warehouse_slug": warehouse.Metadata.Slug,
+		})
+	}
+
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("warehouses", flatWarehouses); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(filter.id(sourceID))
+
+	return diags
+}
@@ -0,0 +1,276 @@
+// Package export renders the sources, destinations and warehouses of a
+// workspace as Terraform configuration, with import blocks to adopt them.
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+)
+
+const header = `# Generated by terraform-provider-segment export.
+#
+# Warehouse passwords can't be read back from Segment and are left out, and
+# destination settings can hold credentials, review them before committing.
+
+`
+
+// Workspace holds the objects of a workspace that get exported.
+type Workspace struct {
+	Sources      []segment.Source
+	Destinations []segment.Destination
+	Warehouses   []segment.Warehouse
+}
+
+// ReadWorkspace lists the sources, destinations and warehouses of the
+// workspace the client has access to.
+func ReadWorkspace(c *segment.Client) (*Workspace, error) {
+	sources, err := c.ListSources()
+	if err != nil {
+		return nil, fmt.Errorf("listing sources: %s", err)
+	}
+	destinations, err := c.ListDestinations()
+	if err != nil {
+		return nil, fmt.Errorf("listing destinations: %s", err)
+	}
+	warehouses, err := c.ListWarehouses()
+	if err != nil {
+		return nil, fmt.Errorf("listing warehouses: %s", err)
+	}
+
+	return &Workspace{
+		Sources:      sources,
+		Destinations: destinations,
+		Warehouses:   warehouses,
+	}, nil
+}
+
+// WriteHCL writes a resource block for every object of the workspace,
+// preceded by the import block that adopts it. Source IDs are written as
+// references to the exported sources, except where that would be a cycle.
+func (w *Workspace) WriteHCL(out io.Writer) error {
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+
+	sources := make([]segment.Source, len(w.Sources))
+	copy(sources, w.Sources)
+	sort.Slice(sources, func(i, j int) bool { return sources[i].Slug < sources[j].Slug })
+
+	destinations := make([]segment.Destination, len(w.Destinations))
+	copy(destinations, w.Destinations)
+
+	warehouses := make([]segment.Warehouse, len(w.Warehouses))
+	copy(warehouses, w.Warehouses)
+	sort.Slice(warehouses, func(i, j int) bool { return warehouses[i].Name < warehouses[j].Name })
+
+	names := newNames()
+	sourceNames := make(map[string]string)
+	sourceSlugs := make(map[string]string)
+	for _, source := range sources {
+		sourceNames[*source.ID] = names.add("segment_source", source.Slug)
+		sourceSlugs[*source.ID] = source.Slug
+	}
+	sort.Slice(destinations, func(i, j int) bool {
+		if sourceSlugs[destinations[i].SourceID] != sourceSlugs[destinations[j].SourceID] {
+			return sourceSlugs[destinations[i].SourceID] < sourceSlugs[destinations[j].SourceID]
+		}
+		return destinations[i].Name < destinations[j].Name
+	})
+
+	// Sources only refer to sources written after them, referring to
+	// themselves or back to earlier ones could make a dependency cycle
+	laterSources := make(map[string]string, len(sourceNames))
+	for id, name := range sourceNames {
+		laterSources[id] = name
+	}
+	for _, source := range sources {
+		name := sourceNames[*source.ID]
+		delete(laterSources, *source.ID)
+		appendImport(body, "segment_source", name, *source.ID)
+
+		resource := body.AppendNewBlock("resource", []string{"segment_source", name}).Body()
+		resource.SetAttributeValue("slug", cty.StringVal(source.Slug))
+		resource.SetAttributeValue("name", cty.StringVal(source.Name))
+		resource.SetAttributeValue("enabled", cty.BoolVal(source.Enabled))
+		if contains(segment.SourceSlugs, source.Metadata.Slug) {
+			resource.SetAttributeValue("source_slug", cty.StringVal(source.Metadata.Slug))
+		} else {
+			resource.SetAttributeValue("metadata_id", cty.StringVal(source.Metadata.ID))
+		}
+
+		settings := resource.AppendNewBlock("settings", nil).Body()
+		setSourceID(settings, "forwarding_violations_to", source.Settings.ForwardingViolationsTo, laterSources)
+		setSourceID(settings, "forwarding_blocked_events_to", source.Settings.ForwardingBlockedEventsTo, laterSources)
+
+		track := settings.AppendNewBlock("track", nil).Body()
+		track.SetAttributeValue("allow_unplanned_events", cty.BoolVal(source.Settings.Track.AllowUnplannedEvents))
+		track.SetAttributeValue("allow_unplanned_event_properties", cty.BoolVal(source.Settings.Track.AllowUnplannedEventProperties))
+		track.SetAttributeValue("allow_event_on_violations", cty.BoolVal(source.Settings.Track.AllowEventOnViolations))
+		track.SetAttributeValue("allow_properties_on_violations", cty.BoolVal(source.Settings.Track.AllowPropertiesOnViolations))
+		setString(track, "common_event_on_violations", source.Settings.Track.CommonEventOnViolations)
+
+		identify := settings.AppendNewBlock("identify", nil).Body()
+		identify.SetAttributeValue("allow_unplanned_traits", cty.BoolVal(source.Settings.Identify.AllowUnplannedTraits))
+		identify.SetAttributeValue("allow_traits_on_violations", cty.BoolVal(source.Settings.Identify.AllowTraitsOnViolations))
+		setString(identify, "common_event_on_violations", source.Settings.Identify.CommonEventOnViolations)
+
+		group := settings.AppendNewBlock("group", nil).Body()
+		group.SetAttributeValue("allow_unplanned_traits", cty.BoolVal(source.Settings.Group.AllowUnplannedTraits))
+		group.SetAttributeValue("allow_traits_on_violations", cty.BoolVal(source.Settings.Group.AllowTraitsOnViolations))
+		setString(group, "common_event_on_violations", source.Settings.Group.CommonEventOnViolations)
+		body.AppendNewline()
+	}
+
+	for _, destination := range destinations {
+		label := destination.Name
+		if slug, ok := sourceSlugs[destination.SourceID]; ok {
+			label = slug + "_" + destination.Name
+		}
+		name := names.add("segment_destination", label)
+		appendImport(body, "segment_destination", name, *destination.ID)
+
+		resource := body.AppendNewBlock("resource", []string{"segment_destination", name}).Body()
+		resource.SetAttributeValue("name", cty.StringVal(destination.Name))
+		resource.SetAttributeValue("enabled", cty.BoolVal(destination.Enabled))
+		if contains(segment.DestinationSlugs, destination.Metadata.Slug) {
+			resource.SetAttributeValue("destination_slug", cty.StringVal(destination.Metadata.Slug))
+		} else {
+			resource.SetAttributeValue("metadata_id", cty.StringVal(destination.Metadata.ID))
+		}
+		setSourceID(resource, "source_id", destination.SourceID, sourceNames)
+
+		settings, err := destinationSettings(destination.Settings)
+		if err != nil {
+			return fmt.Errorf("settings of destination %s: %s", *destination.ID, err)
+		}
+		resource.SetAttributeValue("settings", settings)
+		body.AppendNewline()
+	}
+
+	for _, warehouse := range warehouses {
+		name := names.add("segment_warehouse", warehouse.Name)
+		appendImport(body, "segment_warehouse", name, *warehouse.ID)
+
+		resource := body.AppendNewBlock("resource", []string{"segment_warehouse", name}).Body()
+		resource.SetAttributeValue("name", cty.StringVal(warehouse.Name))
+		resource.SetAttributeValue("enabled", cty.BoolVal(warehouse.Enabled))
+		resource.SetAttributeValue("warehouse_slug", cty.StringVal(warehouse.Metadata.Slug))
+
+		settings := resource.AppendNewBlock("settings", nil).Body()
+		setString(settings, "hostname", warehouse.Settings.Hostname)
+		setString(settings, "database", warehouse.Settings.Database)
+		if port, err := strconv.Atoi(warehouse.Settings.Port); err == nil {
+			settings.SetAttributeValue("port", cty.NumberIntVal(int64(port)))
+		}
+		setString(settings, "username", warehouse.Settings.Username)
+		body.AppendNewline()
+	}
+
+	if _, err := io.WriteString(out, header); err != nil {
+		return err
+	}
+	_, err := out.Write(append(bytes.TrimRight(hclwrite.Format(f.Bytes()), "\n"), '\n'))
+	return err
+}
+
+func appendImport(body *hclwrite.Body, resourceType string, name string, id string) {
+	block := body.AppendNewBlock("import", nil).Body()
+	block.SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: name},
+	})
+	block.SetAttributeValue("id", cty.StringVal(id))
+	body.AppendNewline()
+}
+
+func setString(body *hclwrite.Body, name string, value string) {
+	if value != "" {
+		body.SetAttributeValue(name, cty.StringVal(value))
+	}
+}
+
+// setSourceID refers to the exported source when there is one, and falls back
+// to the ID otherwise.
+func setSourceID(body *hclwrite.Body, name string, sourceID string, sourceNames map[string]string) {
+	if sourceName, ok := sourceNames[sourceID]; ok {
+		body.SetAttributeTraversal(name, hcl.Traversal{
+			hcl.TraverseRoot{Name: "segment_source"},
+			hcl.TraverseAttr{Name: sourceName},
+			hcl.TraverseAttr{Name: "id"},
+		})
+		return
+	}
+	setString(body, name, sourceID)
+}
+
+// destinationSettings converts settings to the map of strings
+// segment_destination takes, values that aren't strings are JSON encoded.
+func destinationSettings(settings map[string]interface{}) (cty.Value, error) {
+	if len(settings) == 0 {
+		return cty.MapValEmpty(cty.String), nil
+	}
+
+	values := make(map[string]cty.Value, len(settings))
+	for key, value := range settings {
+		switch v := value.(type) {
+		case string:
+			values[key] = cty.StringVal(v)
+		case nil:
+			values[key] = cty.StringVal("")
+		default:
+			encoded, err := json.Marshal(v)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			values[key] = cty.StringVal(string(encoded))
+		}
+	}
+	return cty.MapVal(values), nil
+}
+
+var nonIdentifierChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// names hands out resource names that are valid identifiers and unique per
+// resource type.
+type names map[string]bool
+
+func newNames() names {
+	return make(names)
+}
+
+func (n names) add(resourceType string, label string) string {
+	name := strings.Trim(nonIdentifierChars.ReplaceAllString(strings.ToLower(label), "_"), "_")
+	kind := strings.TrimPrefix(resourceType, "segment_")
+	if name == "" {
+		name = kind
+	} else if name[0] >= '0' && name[0] <= '9' {
+		name = kind + "_" + name
+	}
+
+	unique := name
+	for i := 2; n[resourceType+"."+unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", name, i)
+	}
+	n[resourceType+"."+unique] = true
+	return unique
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+package export_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/export"
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+)
+
+func TestWriteHCL(t *testing.T) {
+	sourceID := "src1"
+	otherSourceID := "src0"
+	destinationID := "dst1"
+	otherDestinationID := "dst2"
+	warehouseID := "wh1"
+
+	workspace := export.Workspace{
+		Sources: []segment.Source{
+			{
+				ID:       &sourceID,
+				Slug:     "web-site",
+				Name:     "Web site",
+				Enabled:  true,
+				Metadata: segment.SourceMetadata{ID: "m1", Slug: "javascript"},
+				Settings: segment.SourceSettings{ForwardingViolationsTo: sourceID, ForwardingBlockedEventsTo: otherSourceID},
+			},
+			{
+				ID:       &otherSourceID,
+				Slug:     "app",
+				Name:     "App",
+				Metadata: segment.SourceMetadata{ID: "m4", Slug: "ios"},
+				Settings: segment.SourceSettings{ForwardingBlockedEventsTo: sourceID},
+			},
+		},
+		Destinations: []segment.Destination{
+			{
+				ID:       &destinationID,
+				Name:     "GTM",
+				SourceID: sourceID,
+				Metadata: segment.DestinationMetadata{ID: "m2", Slug: "google-tag-manager"},
+				Settings: map[string]interface{}{"containerId": "GTM-1", "track named pages": true, "value": "${x}"},
+			},
+			{
+				ID:       &otherDestinationID,
+				Name:     "GTM",
+				SourceID: "elsewhere",
+				Metadata: segment.DestinationMetadata{ID: "m3", Slug: "my-function"},
+			},
+		},
+		Warehouses: []segment.Warehouse{
+			{
+				ID:       &warehouseID,
+				Name:     "1st warehouse",
+				Enabled:  false,
+				Metadata: segment.WarehouseMetadata{Slug: "snowflake"},
+				Settings: segment.WarehouseSettings{Hostname: "example.snowflakecomputing.com", Port: "443", Password: "secret"},
+			},
+		},
+	}
+
+	var out bytes.Buffer
+	if err := workspace.WriteHCL(&out); err != nil {
+		t.Fatal(err)
+	}
+	got := out.String()
+
+	for _, want := range []string{
+		"import {\n  to = segment_source.web_site\n  id = \"src1\"\n}\n",
+		"resource \"segment_source\" \"web_site\" {\n",
+		"    forwarding_blocked_events_to = segment_source.web_site.id\n",
+		"    forwarding_violations_to     = \"src1\"\n",
+		"    forwarding_blocked_events_to = \"src0\"\n",
+		"  source_slug = \"javascript\"\n",
+		"import {\n  to = segment_destination.web_site_gtm\n  id = \"dst1\"\n}\n",
+		"  source_id        = segment_source.web_site.id\n",
+		"    \"track named pages\" = \"true\"\n",
+		"    value               = \"$${x}\"\n",
+		"resource \"segment_destination\" \"gtm\" {\n",
+		"  metadata_id = \"m3\"\n",
+		"  source_id   = \"elsewhere\"\n",
+		"  settings    = {}\n",
+		"resource \"segment_warehouse\" \"warehouse_1st_warehouse\" {\n",
+		"    port     = 443\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "secret") {
+		t.Errorf("expected the warehouse password to be left out, got:\n%s", got)
+	}
+}
@@ -0,0 +1,63 @@
+package fql
+
+// Node is an element of a parsed expression.
+type Node interface {
+	Pos() Pos
+}
+
+// BinaryExpr is a comparison (=, !=, <, <=, >, >=) or a logical and/or.
+type BinaryExpr struct {
+	Op    TokenKind
+	OpPos Pos
+	X     Node
+	Y     Node
+}
+
+// NotExpr is a negation, !X.
+type NotExpr struct {
+	Not Pos
+	X   Node
+}
+
+// CallExpr is a function call such as contains(properties.name, "x").
+type CallExpr struct {
+	Name    string
+	NamePos Pos
+	Args    []Node
+}
+
+// Path is a reference to a field in the event, e.g. properties.product\-id.
+// Segments hold the unescaped field names.
+type Path struct {
+	Segments []string
+	Start    Pos
+}
+
+type StringLit struct {
+	Value string
+	Start Pos
+}
+
+// NumberLit keeps the number as written; the printer normalises its form.
+type NumberLit struct {
+	Raw   string
+	Start Pos
+}
+
+type BoolLit struct {
+	Value bool
+	Start Pos
+}
+
+type NullLit struct {
+	Start Pos
+}
+
+func (e *BinaryExpr) Pos() Pos { return e.X.Pos() }
+func (e *NotExpr) Pos() Pos    { return e.Not }
+func (e *CallExpr) Pos() Pos   { return e.NamePos }
+func (e *Path) Pos() Pos       { return e.Start }
+func (e *StringLit) Pos() Pos  { return e.Start }
+func (e *NumberLit) Pos() Pos  { return e.Start }
+func (e *BoolLit) Pos() Pos    { return e.Start }
+func (e *NullLit) Pos() Pos    { return e.Start }
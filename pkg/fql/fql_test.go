@@ -0,0 +1,91 @@
+package fql_test
+
+import (
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/fql"
+)
+
+func TestNormalize(t *testing.T) {
+	cases := []struct {
+		in   string
+		want string
+	}{
+		{`event = "Order Completed"`, `event = "Order Completed"`},
+		{`  type="track"   and event="Signed Up" `, `type = "track" and event = "Signed Up"`},
+		{`(type = "track") and ((event = "A") or (event = "B"))`, `type = "track" and (event = "A" or event = "B")`},
+		{`a = 1 and (b = 2 and c = 3)`, `a = 1 and b = 2 and c = 3`},
+		{`a = 1 or (b = 2 and c = 3)`, `a = 1 or b = 2 and c = 3`},
+		{`!(event = "A")`, `!(event = "A")`},
+		{`!contains(context.library.name,"analytics")`, `!contains(context.library.name, "analytics")`},
+		{`properties.value >= 10.50`, `properties.value >= 10.5`},
+		{`properties.count < -1.0`, `properties.count < -1`},
+		{`properties.product\-id = "x"`, `properties.product\-id = "x"`},
+		{`match(lowercase(properties.email), "*@example.com")`, `match(lowercase(properties.email), "*@example.com")`},
+		{`properties.name = "say \"hi\""`, `properties.name = "say \"hi\""`},
+		{`traits.email != null and length(traits.email) > 3`, `traits.email != null and length(traits.email) > 3`},
+		{`typeof(properties.price) = "number"`, `typeof(properties.price) = "number"`},
+		{`properties.flag = true`, `properties.flag = true`},
+		{`a = 12345678901234567890`, `a = 12345678901234567890`},
+		{`a = 0.10000000000000000001`, `a = 0.10000000000000000001`},
+		{`a = 007.50 and b = -0.0`, `a = 7.5 and b = 0`},
+		{`a > 1e3 and b < 2.50E+03 and c = 1e-04 and d = 5e0`, `a > 1e3 and b < 2.5e3 and c = 1e-4 and d = 5`},
+	}
+
+	for _, c := range cases {
+		got, err := fql.Normalize(c.in)
+		if err != nil {
+			t.Errorf("Normalize(%q) returned error: %s", c.in, err)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("Normalize(%q) = %q, want %q", c.in, got, c.want)
+		}
+
+		again, err := fql.Normalize(got)
+		if err != nil || again != got {
+			t.Errorf("Normalize(%q) is not stable: %q, %v", got, again, err)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	cases := []struct {
+		in   string
+		want string
+	}{
+		{``, `1:1: expression is empty`},
+		{`event = `, `1:9: expected field, value or "(", found end of expression`},
+		{`event = "Order`, `1:9: unterminated string`},
+		{`event == "A"`, `1:8: expected field, value or "(", found "="`},
+		{`event = "A" and`, `1:16: expected field, value or "(", found end of expression`},
+		{`event = "A" AND type = "track"`, `1:13: expected and, or or end of expression, found field AND`},
+		{`(event = "A"`, `1:13: expected ")", found end of expression`},
+		{`a = 1 = 2`, `1:7: comparisons cannot be chained, use and/or`},
+		{`event = "A" & type = "track"`, `1:13: unexpected character '&'`},
+		{`properties..name = 1`, `1:12: empty field name in path`},
+		{`upper(event) = "A"`, `1:1: unknown function upper`},
+		{`contains(event)`, `1:1: contains expects 2 argument(s), got 1`},
+		{`contains(event, 1)`, `1:17: argument 2 of contains must be a string, got number`},
+		{`properties.value > "10"`, `1:20: operand of > must be a number, got string`},
+		{`event = "A" and "B"`, `1:17: operand of and must be a boolean, got string`},
+		{`lowercase(event)`, `1:1: expression must evaluate to a boolean, got string`},
+		{"type = \"track\"\n  and event = 12x", `2:17: unexpected character 'x' in number`},
+		{`a = 1e`, `1:7: expected digit in exponent`},
+		{`a = 1e3x`, `1:8: unexpected character 'x' in number`},
+	}
+
+	for _, c := range cases {
+		_, err := fql.Parse(c.in)
+		if err == nil {
+			t.Errorf("Parse(%q) succeeded, want error %q", c.in, c.want)
+			continue
+		}
+		if err.Error() != c.want {
+			t.Errorf("Parse(%q) error = %q, want %q", c.in, err.Error(), c.want)
+		}
+		if _, ok := err.(*fql.Error); !ok {
+			t.Errorf("Parse(%q) error is %T, want *fql.Error", c.in, err)
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package fql
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type lexer struct {
+	src  string
+	pos  Pos
+	peek rune
+	size int
+}
+
+func newLexer(src string) *lexer {
+	l := &lexer{
+		src: src,
+		pos: Pos{Offset: 0, Line: 1, Column: 1},
+	}
+	l.load()
+	return l
+}
+
+func (l *lexer) load() {
+	if l.pos.Offset >= len(l.src) {
+		l.peek, l.size = -1, 0
+		return
+	}
+	l.peek, l.size = utf8.DecodeRuneInString(l.src[l.pos.Offset:])
+}
+
+func (l *lexer) advance() {
+	if l.peek == -1 {
+		return
+	}
+	if l.peek == '\n' {
+		l.pos.Line++
+		l.pos.Column = 1
+	} else {
+		l.pos.Column++
+	}
+	l.pos.Offset += l.size
+	l.load()
+}
+
+func (l *lexer) next() (Token, error) {
+	for l.peek != -1 && unicode.IsSpace(l.peek) {
+		l.advance()
+	}
+
+	start := l.pos
+	r := l.peek
+
+	switch {
+	case r == -1:
+		return Token{Kind: EOF, Pos: start}, nil
+	case r == '"':
+		return l.scanString()
+	case isDigit(r) || (r == '-' && l.nextIsDigit()):
+		return l.scanNumber()
+	case isIdentStart(r) || r == '\\':
+		return l.scanIdent()
+	}
+
+	l.advance()
+	switch r {
+	case '(':
+		return Token{Kind: LPAREN, Text: "(", Pos: start}, nil
+	case ')':
+		return Token{Kind: RPAREN, Text: ")", Pos: start}, nil
+	case ',':
+		return Token{Kind: COMMA, Text: ",", Pos: start}, nil
+	case '=':
+		return Token{Kind: EQ, Text: "=", Pos: start}, nil
+	case '!':
+		if l.peek == '=' {
+			l.advance()
+			return Token{Kind: NEQ, Text: "!=", Pos: start}, nil
+		}
+		return Token{Kind: NOT, Text: "!", Pos: start}, nil
+	case '<':
+		if l.peek == '=' {
+			l.advance()
+			return Token{Kind: LTE, Text: "<=", Pos: start}, nil
+		}
+		return Token{Kind: LT, Text: "<", Pos: start}, nil
+	case '>':
+		if l.peek == '=' {
+			l.advance()
+			return Token{Kind: GTE, Text: ">=", Pos: start}, nil
+		}
+		return Token{Kind: GT, Text: ">", Pos: start}, nil
+	}
+
+	return Token{Kind: ILLEGAL, Text: string(r), Pos: start}, &Error{Pos: start, Msg: "unexpected character " + quoteRune(r)}
+}
+
+func (l *lexer) nextIsDigit() bool {
+	r, _ := utf8.DecodeRuneInString(l.src[l.pos.Offset+l.size:])
+	return isDigit(r)
+}
+
+func (l *lexer) scanString() (Token, error) {
+	start := l.pos
+	l.advance()
+
+	var b strings.Builder
+	for {
+		switch l.peek {
+		case -1, '\n':
+			return Token{Kind: ILLEGAL, Pos: start}, &Error{Pos: start, Msg: "unterminated string"}
+		case '"':
+			l.advance()
+			return Token{Kind: STRING, Text: b.String(), Pos: start}, nil
+		case '\\':
+			l.advance()
+			switch l.peek {
+			case -1:
+				return Token{Kind: ILLEGAL, Pos: start}, &Error{Pos: start, Msg: "unterminated string"}
+			case 'n':
+				b.WriteRune('\n')
+			case 't':
+				b.WriteRune('\t')
+			case 'r':
+				b.WriteRune('\r')
+			default:
+				b.WriteRune(l.peek)
+			}
+			l.advance()
+		default:
+			b.WriteRune(l.peek)
+			l.advance()
+		}
+	}
+}
+
+func (l *lexer) scanNumber() (Token, error) {
+	start := l.pos
+	if l.peek == '-' {
+		l.advance()
+	}
+	for isDigit(l.peek) {
+		l.advance()
+	}
+	if l.peek == '.' {
+		l.advance()
+		if !isDigit(l.peek) {
+			return Token{Kind: ILLEGAL, Pos: start}, &Error{Pos: l.pos, Msg: "expected digit after decimal point"}
+		}
+		for isDigit(l.peek) {
+			l.advance()
+		}
+	}
+	if l.peek == 'e' || l.peek == 'E' {
+		l.advance()
+		if l.peek == '+' || l.peek == '-' {
+			l.advance()
+		}
+		if !isDigit(l.peek) {
+			return Token{Kind: ILLEGAL, Pos: start}, &Error{Pos: l.pos, Msg: "expected digit in exponent"}
+		}
+		for isDigit(l.peek) {
+			l.advance()
+		}
+	}
+	if isIdentStart(l.peek) {
+		return Token{Kind: ILLEGAL, Pos: start}, &Error{Pos: l.pos, Msg: "unexpected character " + quoteRune(l.peek) + " in number"}
+	}
+	return Token{Kind: NUMBER, Text: l.src[start.Offset:l.pos.Offset], Pos: start}, nil
+}
+
+// scanIdent reads a field path or keyword. Path segments are returned still
+// escaped and joined by dots; the parser splits and decodes them.
+func (l *lexer) scanIdent() (Token, error) {
+	start := l.pos
+	for {
+		switch {
+		case l.peek == '\\':
+			l.advance()
+			if l.peek == -1 || unicode.IsSpace(l.peek) {
+				return Token{Kind: ILLEGAL, Pos: start}, &Error{Pos: l.pos, Msg: "expected character after escape in field name"}
+			}
+			l.advance()
+		case l.peek == '.' || isIdentPart(l.peek):
+			l.advance()
+		default:
+			text := l.src[start.Offset:l.pos.Offset]
+			if kind, ok := keywords[text]; ok {
+				return Token{Kind: kind, Text: text, Pos: start}, nil
+			}
+			return Token{Kind: IDENT, Text: text, Pos: start}, nil
+		}
+	}
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+func isIdentStart(r rune) bool {
+	return r == '_' || r == '$' || unicode.IsLetter(r)
+}
+
+func isIdentPart(r rune) bool {
+	return isIdentStart(r) || unicode.IsDigit(r)
+}
+
+func quoteRune(r rune) string {
+	return "'" + string(r) + "'"
+}
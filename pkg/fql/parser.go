@@ -0,0 +1,356 @@
+package fql
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+type kind int
+
+const (
+	kindAny kind = iota
+	kindBool
+	kindString
+	kindNumber
+	kindNull
+)
+
+func (k kind) String() string {
+	switch k {
+	case kindBool:
+		return "boolean"
+	case kindString:
+		return "string"
+	case kindNumber:
+		return "number"
+	case kindNull:
+		return "null"
+	}
+	return "value"
+}
+
+type function struct {
+	args   []kind
+	result kind
+}
+
+var functions = map[string]function{
+	"contains":  {args: []kind{kindAny, kindString}, result: kindBool},
+	"match":     {args: []kind{kindAny, kindString}, result: kindBool},
+	"lowercase": {args: []kind{kindAny}, result: kindString},
+	"length":    {args: []kind{kindAny}, result: kindNumber},
+	"typeof":    {args: []kind{kindAny}, result: kindString},
+}
+
+type parser struct {
+	lex *lexer
+	tok Token
+}
+
+// Parse parses and type checks an FQL expression. The returned error, if
+// any, is an *Error pointing at the offending part of the expression.
+func Parse(src string) (Node, error) {
+	p := &parser{lex: newLexer(src)}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	if p.tok.Kind == EOF {
+		return nil, &Error{Pos: p.tok.Pos, Msg: "expression is empty"}
+	}
+
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok.Kind != EOF {
+		return nil, p.unexpected("and, or or end of expression")
+	}
+
+	k, err := check(node)
+	if err != nil {
+		return nil, err
+	}
+	if k != kindBool && k != kindAny {
+		return nil, &Error{Pos: node.Pos(), Msg: fmt.Sprintf("expression must evaluate to a boolean, got %s", k)}
+	}
+
+	return node, nil
+}
+
+func (p *parser) advance() error {
+	tok, err := p.lex.next()
+	if err != nil {
+		return err
+	}
+	p.tok = tok
+	return nil
+}
+
+func (p *parser) unexpected(expected string) error {
+	found := p.tok.Kind.String()
+	if p.tok.Kind == IDENT || p.tok.Kind == NUMBER {
+		found = fmt.Sprintf("%s %s", found, p.tok.Text)
+	} else if p.tok.Kind == STRING {
+		found = fmt.Sprintf("%s %q", found, p.tok.Text)
+	} else if p.tok.Kind != EOF {
+		found = fmt.Sprintf("%q", found)
+	}
+	return &Error{Pos: p.tok.Pos, Msg: fmt.Sprintf("expected %s, found %s", expected, found)}
+}
+
+func (p *parser) parseOr() (Node, error) {
+	x, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok.Kind == OR {
+		opPos := p.tok.Pos
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		y, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		x = &BinaryExpr{Op: OR, OpPos: opPos, X: x, Y: y}
+	}
+	return x, nil
+}
+
+func (p *parser) parseAnd() (Node, error) {
+	x, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok.Kind == AND {
+		opPos := p.tok.Pos
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		y, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		x = &BinaryExpr{Op: AND, OpPos: opPos, X: x, Y: y}
+	}
+	return x, nil
+}
+
+func (p *parser) parseUnary() (Node, error) {
+	if p.tok.Kind == NOT {
+		notPos := p.tok.Pos
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &NotExpr{Not: notPos, X: x}, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (Node, error) {
+	x, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	if !p.tok.Kind.isComparison() {
+		return x, nil
+	}
+
+	op, opPos := p.tok.Kind, p.tok.Pos
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	y, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok.Kind.isComparison() {
+		return nil, &Error{Pos: p.tok.Pos, Msg: "comparisons cannot be chained, use and/or"}
+	}
+	return &BinaryExpr{Op: op, OpPos: opPos, X: x, Y: y}, nil
+}
+
+func (p *parser) parseOperand() (Node, error) {
+	tok := p.tok
+	switch tok.Kind {
+	case LPAREN:
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		x, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.tok.Kind != RPAREN {
+			return nil, p.unexpected("\")\"")
+		}
+		return x, p.advance()
+	case STRING:
+		return &StringLit{Value: tok.Text, Start: tok.Pos}, p.advance()
+	case NUMBER:
+		return &NumberLit{Raw: tok.Text, Start: tok.Pos}, p.advance()
+	case TRUE, FALSE:
+		return &BoolLit{Value: tok.Kind == TRUE, Start: tok.Pos}, p.advance()
+	case NULL:
+		return &NullLit{Start: tok.Pos}, p.advance()
+	case IDENT:
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		if p.tok.Kind == LPAREN {
+			return p.parseCall(tok)
+		}
+		return parsePath(tok)
+	}
+	return nil, p.unexpected("field, value or \"(\"")
+}
+
+func (p *parser) parseCall(name Token) (Node, error) {
+	call := &CallExpr{Name: name.Text, NamePos: name.Pos}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	if p.tok.Kind == RPAREN {
+		return call, p.advance()
+	}
+	for {
+		arg, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		call.Args = append(call.Args, arg)
+
+		switch p.tok.Kind {
+		case COMMA:
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+		case RPAREN:
+			return call, p.advance()
+		default:
+			return nil, p.unexpected("\",\" or \")\"")
+		}
+	}
+}
+
+// parsePath splits a field token on unescaped dots and removes escapes.
+func parsePath(tok Token) (Node, error) {
+	path := &Path{Start: tok.Pos}
+	pos := tok.Pos
+	segStart := pos
+
+	var seg strings.Builder
+	escaped := false
+	for _, r := range tok.Text {
+		separator := false
+		switch {
+		case escaped:
+			seg.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '.':
+			if seg.Len() == 0 {
+				return nil, &Error{Pos: segStart, Msg: "empty field name in path"}
+			}
+			path.Segments = append(path.Segments, seg.String())
+			seg.Reset()
+			separator = true
+		default:
+			seg.WriteRune(r)
+		}
+
+		pos.Offset += utf8.RuneLen(r)
+		pos.Column++
+		if separator {
+			segStart = pos
+		}
+	}
+	if seg.Len() == 0 {
+		return nil, &Error{Pos: segStart, Msg: "empty field name in path"}
+	}
+	path.Segments = append(path.Segments, seg.String())
+
+	return path, nil
+}
+
+func check(node Node) (kind, error) {
+	switch n := node.(type) {
+	case *BinaryExpr:
+		x, err := check(n.X)
+		if err != nil {
+			return kindAny, err
+		}
+		y, err := check(n.Y)
+		if err != nil {
+			return kindAny, err
+		}
+		switch n.Op {
+		case AND, OR:
+			if err := expect(n.X, x, kindBool, "operand of "+n.Op.String()); err != nil {
+				return kindAny, err
+			}
+			if err := expect(n.Y, y, kindBool, "operand of "+n.Op.String()); err != nil {
+				return kindAny, err
+			}
+		case LT, LTE, GT, GTE:
+			if err := expect(n.X, x, kindNumber, "operand of "+n.Op.String()); err != nil {
+				return kindAny, err
+			}
+			if err := expect(n.Y, y, kindNumber, "operand of "+n.Op.String()); err != nil {
+				return kindAny, err
+			}
+		}
+		return kindBool, nil
+	case *NotExpr:
+		x, err := check(n.X)
+		if err != nil {
+			return kindAny, err
+		}
+		if err := expect(n.X, x, kindBool, "operand of !"); err != nil {
+			return kindAny, err
+		}
+		return kindBool, nil
+	case *CallExpr:
+		fn, ok := functions[n.Name]
+		if !ok {
+			return kindAny, &Error{Pos: n.NamePos, Msg: fmt.Sprintf("unknown function %s", n.Name)}
+		}
+		if len(n.Args) != len(fn.args) {
+			return kindAny, &Error{Pos: n.NamePos, Msg: fmt.Sprintf("%s expects %d argument(s), got %d", n.Name, len(fn.args), len(n.Args))}
+		}
+		for i, arg := range n.Args {
+			k, err := check(arg)
+			if err != nil {
+				return kindAny, err
+			}
+			if err := expect(arg, k, fn.args[i], fmt.Sprintf("argument %d of %s", i+1, n.Name)); err != nil {
+				return kindAny, err
+			}
+		}
+		return fn.result, nil
+	case *Path:
+		return kindAny, nil
+	case *StringLit:
+		return kindString, nil
+	case *NumberLit:
+		return kindNumber, nil
+	case *BoolLit:
+		return kindBool, nil
+	case *NullLit:
+		return kindNull, nil
+	}
+	return kindAny, fmt.Errorf("unknown node %T", node)
+}
+
+func expect(node Node, got kind, want kind, what string) error {
+	if want == kindAny || got == kindAny || got == want {
+		return nil
+	}
+	return &Error{Pos: node.Pos(), Msg: fmt.Sprintf("%s must be a %s, got %s", what, want, got)}
+}
@@ -0,0 +1,198 @@
+package fql
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	precOr = iota + 1
+	precAnd
+	precNot
+	precComparison
+	precOperand
+)
+
+func precedence(node Node) int {
+	switch n := node.(type) {
+	case *BinaryExpr:
+		switch n.Op {
+		case OR:
+			return precOr
+		case AND:
+			return precAnd
+		}
+		return precComparison
+	case *NotExpr:
+		return precNot
+	}
+	return precOperand
+}
+
+// Format prints a parsed expression in canonical form: single spaces around
+// operators, only the parentheses required by precedence, chains of the
+// same logical operator flattened and literals written consistently.
+func Format(node Node) string {
+	var b strings.Builder
+	printNode(&b, node)
+	return b.String()
+}
+
+// Normalize parses an expression and returns its canonical form, so two
+// expressions that only differ in layout normalise to the same string.
+func Normalize(src string) (string, error) {
+	node, err := Parse(src)
+	if err != nil {
+		return "", err
+	}
+	return Format(node), nil
+}
+
+func printNode(b *strings.Builder, node Node) {
+	switch n := node.(type) {
+	case *BinaryExpr:
+		prec := precedence(n)
+		printOperand(b, n, n.X, prec)
+		b.WriteString(" ")
+		b.WriteString(n.Op.String())
+		b.WriteString(" ")
+		printOperand(b, n, n.Y, prec)
+	case *NotExpr:
+		b.WriteString("!")
+		printOperand(b, n, n.X, precOperand)
+	case *CallExpr:
+		b.WriteString(n.Name)
+		b.WriteString("(")
+		for i, arg := range n.Args {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			printOperand(b, n, arg, precOperand)
+		}
+		b.WriteString(")")
+	case *Path:
+		for i, seg := range n.Segments {
+			if i > 0 {
+				b.WriteString(".")
+			}
+			b.WriteString(escapeSegment(seg, i == 0, len(n.Segments) == 1))
+		}
+	case *StringLit:
+		b.WriteString(quoteString(n.Value))
+	case *NumberLit:
+		b.WriteString(formatNumber(n.Raw))
+	case *BoolLit:
+		b.WriteString(strconv.FormatBool(n.Value))
+	case *NullLit:
+		b.WriteString("null")
+	}
+}
+
+// printOperand prints child, wrapping it in parentheses when it binds less
+// tightly than min. Nested and/or of the same kind are associative so they
+// never need parentheses.
+func printOperand(b *strings.Builder, parent Node, child Node, min int) {
+	if p, ok := parent.(*BinaryExpr); ok && (p.Op == AND || p.Op == OR) {
+		if c, ok := child.(*BinaryExpr); ok && c.Op == p.Op {
+			printNode(b, child)
+			return
+		}
+	}
+
+	prec := precedence(child)
+	wrap := prec < min
+	if _, ok := parent.(*BinaryExpr); ok && precedence(parent) == precComparison {
+		wrap = prec <= precComparison
+	}
+	if wrap {
+		b.WriteString("(")
+		printNode(b, child)
+		b.WriteString(")")
+		return
+	}
+	printNode(b, child)
+}
+
+func escapeSegment(seg string, first bool, only bool) string {
+	if only {
+		if _, ok := keywords[seg]; ok {
+			return "\\" + seg
+		}
+	}
+
+	var b strings.Builder
+	for i, r := range seg {
+		if !isIdentPart(r) || (first && i == 0 && isDigit(r)) {
+			b.WriteRune('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func quoteString(s string) string {
+	var b strings.Builder
+	b.WriteRune('"')
+	for _, r := range s {
+		switch r {
+		case '"', '\\':
+			b.WriteRune('\\')
+			b.WriteRune(r)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '\r':
+			b.WriteString(`\r`)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteRune('"')
+	return b.String()
+}
+
+// formatNumber drops redundant zeros and signs from a number but keeps its
+// digits as written, converting through float64 would round large or
+// precise numbers and hide changes to them.
+func formatNumber(raw string) string {
+	sign := ""
+	if strings.HasPrefix(raw, "-") {
+		sign, raw = "-", raw[1:]
+	}
+
+	mantissa, exponent := raw, ""
+	if i := strings.IndexAny(raw, "eE"); i >= 0 {
+		mantissa, exponent = raw[:i], raw[i+1:]
+	}
+
+	integer, fraction := mantissa, ""
+	if i := strings.IndexByte(mantissa, '.'); i >= 0 {
+		integer, fraction = mantissa[:i], mantissa[i+1:]
+	}
+	integer = strings.TrimLeft(integer, "0")
+	fraction = strings.TrimRight(fraction, "0")
+	if integer == "" && fraction == "" {
+		return "0"
+	}
+	if integer == "" {
+		integer = "0"
+	}
+
+	formatted := sign + integer
+	if fraction != "" {
+		formatted += "." + fraction
+	}
+
+	expSign := ""
+	if strings.HasPrefix(exponent, "-") || strings.HasPrefix(exponent, "+") {
+		if exponent[0] == '-' {
+			expSign = "-"
+		}
+		exponent = exponent[1:]
+	}
+	if exponent = strings.TrimLeft(exponent, "0"); exponent != "" {
+		formatted += "e" + expSign + exponent
+	}
+	return formatted
+}
@@ -0,0 +1,109 @@
+// Package fql implements a lexer, parser and printer for Segment's Filter
+// Query Language, as used by destination filters, subscription triggers and
+// transformations. It allows expressions to be validated without a round
+// trip to the Public API and normalised so that semantically identical
+// expressions compare equal.
+package fql
+
+import "fmt"
+
+type TokenKind int
+
+const (
+	ILLEGAL TokenKind = iota
+	EOF
+
+	IDENT  // properties.foo
+	STRING // "foo"
+	NUMBER // 12.5
+
+	LPAREN // (
+	RPAREN // )
+	COMMA  // ,
+	NOT    // !
+
+	EQ  // =
+	NEQ // !=
+	LT  // <
+	LTE // <=
+	GT  // >
+	GTE // >=
+
+	AND   // and
+	OR    // or
+	TRUE  // true
+	FALSE // false
+	NULL  // null
+)
+
+var tokenNames = map[TokenKind]string{
+	ILLEGAL: "illegal token",
+	EOF:     "end of expression",
+	IDENT:   "field",
+	STRING:  "string",
+	NUMBER:  "number",
+	LPAREN:  "(",
+	RPAREN:  ")",
+	COMMA:   ",",
+	NOT:     "!",
+	EQ:      "=",
+	NEQ:     "!=",
+	LT:      "<",
+	LTE:     "<=",
+	GT:      ">",
+	GTE:     ">=",
+	AND:     "and",
+	OR:      "or",
+	TRUE:    "true",
+	FALSE:   "false",
+	NULL:    "null",
+}
+
+var keywords = map[string]TokenKind{
+	"and":   AND,
+	"or":    OR,
+	"true":  TRUE,
+	"false": FALSE,
+	"null":  NULL,
+}
+
+func (k TokenKind) String() string {
+	if name, ok := tokenNames[k]; ok {
+		return name
+	}
+	return fmt.Sprintf("token(%d)", int(k))
+}
+
+func (k TokenKind) isComparison() bool {
+	return k >= EQ && k <= GTE
+}
+
+// Pos is a position within an expression. Line and Column are 1-based and
+// Column counts characters rather than bytes.
+type Pos struct {
+	Offset int
+	Line   int
+	Column int
+}
+
+func (p Pos) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
+
+type Token struct {
+	Kind TokenKind
+	// Text holds the decoded value for STRING tokens and the raw source for
+	// everything else.
+	Text string
+	Pos  Pos
+}
+
+// Error is a syntax or validation error at a specific position.
+type Error struct {
+	Pos Pos
+	Msg string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Pos, e.Msg)
+}
@@ -0,0 +1,278 @@
+// Package jsonschema checks that documents are well formed JSON Schema
+// (draft-07) before they are sent to Segment, so mistakes in tracking plan
+// rules are reported at plan time with the location of the problem.
+package jsonschema
+
+import (
+	"fmt"
+	"regexp/syntax"
+	"sort"
+	"strings"
+)
+
+const Draft07 = "http://json-schema.org/draft-07/schema#"
+
+var simpleTypes = map[string]bool{
+	"array":   true,
+	"boolean": true,
+	"integer": true,
+	"null":    true,
+	"number":  true,
+	"object":  true,
+	"string":  true,
+}
+
+// Error describes a keyword with an invalid value. Pointer is the JSON
+// Pointer of the keyword within the document.
+type Error struct {
+	Pointer string
+	Msg     string
+}
+
+func (e *Error) Error() string {
+	pointer := e.Pointer
+	if pointer == "" {
+		pointer = "/"
+	}
+	return fmt.Sprintf("%s: %s", pointer, e.Msg)
+}
+
+// ValidateDraft07 validates a decoded JSON document (as produced by
+// encoding/json) against the draft-07 meta-schema.
+func ValidateDraft07(document interface{}) error {
+	if obj, ok := document.(map[string]interface{}); ok {
+		if s, ok := obj["$schema"]; ok {
+			uri, ok := s.(string)
+			if !ok {
+				return &Error{Pointer: "/$schema", Msg: "must be a string"}
+			}
+			if strings.TrimSuffix(uri, "#") != strings.TrimSuffix(Draft07, "#") {
+				return &Error{Pointer: "/$schema", Msg: fmt.Sprintf("unsupported schema %q, expected %q", uri, Draft07)}
+			}
+		}
+	}
+	return validateSchema(document, "")
+}
+
+func validateSchema(v interface{}, pointer string) error {
+	if _, ok := v.(bool); ok {
+		return nil
+	}
+	obj, ok := v.(map[string]interface{})
+	if !ok {
+		return &Error{Pointer: pointer, Msg: "schema must be an object or a boolean"}
+	}
+
+	// Walk keywords in a stable order so the same error is always reported
+	for _, k := range sortedKeys(obj) {
+		if err := validateKeyword(k, obj[k], pointer+"/"+escapePointer(k)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateKeyword(keyword string, v interface{}, pointer string) error {
+	switch keyword {
+	case "$id", "$schema", "$ref", "$comment", "title", "description", "format", "contentMediaType", "contentEncoding":
+		return expectString(v, pointer)
+
+	case "pattern":
+		if err := expectString(v, pointer); err != nil {
+			return err
+		}
+		return validatePattern(v.(string), pointer)
+
+	case "type":
+		return validateType(v, pointer)
+
+	case "multipleOf":
+		n, ok := v.(float64)
+		if !ok || n <= 0 {
+			return &Error{Pointer: pointer, Msg: "must be a number greater than 0"}
+		}
+
+	case "maximum", "exclusiveMaximum", "minimum", "exclusiveMinimum":
+		if _, ok := v.(float64); !ok {
+			return &Error{Pointer: pointer, Msg: "must be a number"}
+		}
+
+	case "maxLength", "minLength", "maxItems", "minItems", "maxProperties", "minProperties":
+		return expectNonNegativeInteger(v, pointer)
+
+	case "readOnly", "writeOnly", "uniqueItems":
+		if _, ok := v.(bool); !ok {
+			return &Error{Pointer: pointer, Msg: "must be a boolean"}
+		}
+
+	case "required":
+		return expectUniqueStrings(v, pointer)
+
+	case "enum":
+		if _, ok := v.([]interface{}); !ok {
+			return &Error{Pointer: pointer, Msg: "must be an array"}
+		}
+
+	case "examples":
+		if _, ok := v.([]interface{}); !ok {
+			return &Error{Pointer: pointer, Msg: "must be an array"}
+		}
+
+	case "additionalItems", "additionalProperties", "contains", "propertyNames", "not", "if", "then", "else":
+		return validateSchema(v, pointer)
+
+	case "items":
+		if items, ok := v.([]interface{}); ok {
+			return validateSchemaArray(items, pointer, false)
+		}
+		return validateSchema(v, pointer)
+
+	case "allOf", "anyOf", "oneOf":
+		items, ok := v.([]interface{})
+		if !ok {
+			return &Error{Pointer: pointer, Msg: "must be an array of schemas"}
+		}
+		return validateSchemaArray(items, pointer, true)
+
+	case "properties", "patternProperties", "definitions":
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return &Error{Pointer: pointer, Msg: "must be an object of schemas"}
+		}
+		for _, k := range sortedKeys(obj) {
+			if keyword == "patternProperties" {
+				if err := validatePattern(k, pointer+"/"+escapePointer(k)); err != nil {
+					return err
+				}
+			}
+			if err := validateSchema(obj[k], pointer+"/"+escapePointer(k)); err != nil {
+				return err
+			}
+		}
+
+	case "dependencies":
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return &Error{Pointer: pointer, Msg: "must be an object"}
+		}
+		for _, k := range sortedKeys(obj) {
+			p := pointer + "/" + escapePointer(k)
+			if _, ok := obj[k].([]interface{}); ok {
+				if err := expectUniqueStrings(obj[k], p); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := validateSchema(obj[k], p); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Unknown keywords are allowed by the specification
+	return nil
+}
+
+// validatePattern only reports mistakes that make a pattern invalid in any
+// dialect. Patterns are ECMA-262 regular expressions, which Go's RE2 syntax
+// doesn't fully cover, i.e. lookarounds and backreferences, so whatever else
+// RE2 rejects is let through.
+func validatePattern(pattern string, pointer string) error {
+	_, err := syntax.Parse(pattern, syntax.Perl)
+	syntaxErr, ok := err.(*syntax.Error)
+	if !ok {
+		return nil
+	}
+	switch syntaxErr.Code {
+	case syntax.ErrMissingParen, syntax.ErrUnexpectedParen, syntax.ErrMissingBracket, syntax.ErrMissingRepeatArgument, syntax.ErrTrailingBackslash:
+		return &Error{Pointer: pointer, Msg: fmt.Sprintf("invalid regular expression: %s", err)}
+	}
+	return nil
+}
+
+func validateType(v interface{}, pointer string) error {
+	if s, ok := v.(string); ok {
+		if !simpleTypes[s] {
+			return &Error{Pointer: pointer, Msg: fmt.Sprintf("unknown type %q", s)}
+		}
+		return nil
+	}
+
+	types, ok := v.([]interface{})
+	if !ok || len(types) == 0 {
+		return &Error{Pointer: pointer, Msg: "must be a type name or a non-empty array of type names"}
+	}
+	seen := make(map[string]bool, len(types))
+	for i, t := range types {
+		s, ok := t.(string)
+		p := fmt.Sprintf("%s/%d", pointer, i)
+		if !ok || !simpleTypes[s] {
+			return &Error{Pointer: p, Msg: fmt.Sprintf("unknown type %v", t)}
+		}
+		if seen[s] {
+			return &Error{Pointer: p, Msg: fmt.Sprintf("duplicate type %q", s)}
+		}
+		seen[s] = true
+	}
+	return nil
+}
+
+func validateSchemaArray(items []interface{}, pointer string, nonEmpty bool) error {
+	if nonEmpty && len(items) == 0 {
+		return &Error{Pointer: pointer, Msg: "must contain at least one schema"}
+	}
+	for i, item := range items {
+		if err := validateSchema(item, fmt.Sprintf("%s/%d", pointer, i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func expectString(v interface{}, pointer string) error {
+	if _, ok := v.(string); !ok {
+		return &Error{Pointer: pointer, Msg: "must be a string"}
+	}
+	return nil
+}
+
+func expectNonNegativeInteger(v interface{}, pointer string) error {
+	n, ok := v.(float64)
+	if !ok || n < 0 || n != float64(int64(n)) {
+		return &Error{Pointer: pointer, Msg: "must be a non-negative integer"}
+	}
+	return nil
+}
+
+func expectUniqueStrings(v interface{}, pointer string) error {
+	items, ok := v.([]interface{})
+	if !ok {
+		return &Error{Pointer: pointer, Msg: "must be an array of strings"}
+	}
+	seen := make(map[string]bool, len(items))
+	for i, item := range items {
+		s, ok := item.(string)
+		p := fmt.Sprintf("%s/%d", pointer, i)
+		if !ok {
+			return &Error{Pointer: p, Msg: "must be a string"}
+		}
+		if seen[s] {
+			return &Error{Pointer: p, Msg: fmt.Sprintf("duplicate value %q", s)}
+		}
+		seen[s] = true
+	}
+	return nil
+}
+
+func sortedKeys(obj map[string]interface{}) []string {
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func escapePointer(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "~", "~0"), "/", "~1")
+}
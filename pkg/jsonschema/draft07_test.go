@@ -0,0 +1,47 @@
+package jsonschema_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/jsonschema"
+)
+
+func TestValidateDraft07(t *testing.T) {
+	cases := []struct {
+		schema string
+		want   string
+	}{
+		{`{"$schema": "http://json-schema.org/draft-07/schema#", "type": "object"}`, ""},
+		{`{"type": "object", "properties": {"properties": {"type": "object", "required": ["order_id"], "properties": {"order_id": {"type": ["string", "integer"]}}}}}`, ""},
+		{`{"anyOf": [{"type": "string", "pattern": "^[a-z]+$"}, true]}`, ""},
+		{`{"$schema": "http://json-schema.org/draft-04/schema#"}`, `/$schema: unsupported schema "http://json-schema.org/draft-04/schema#", expected "http://json-schema.org/draft-07/schema#"`},
+		{`"object"`, `/: schema must be an object or a boolean`},
+		{`{"type": "text"}`, `/type: unknown type "text"`},
+		{`{"type": ["string", "string"]}`, `/type/1: duplicate type "string"`},
+		{`{"properties": {"a/b": {"minLength": -1}}}`, `/properties/a~1b/minLength: must be a non-negative integer`},
+		{`{"required": "order_id"}`, `/required: must be an array of strings`},
+		{`{"oneOf": []}`, `/oneOf: must contain at least one schema`},
+		{`{"items": [{"type": "string"}, 1]}`, `/items/1: schema must be an object or a boolean`},
+		{`{"pattern": "("}`, "/pattern: invalid regular expression: error parsing regexp: missing closing ): `(`"},
+		{`{"pattern": "^(?=.*[0-9])(?!test).+$"}`, ""},
+		{`{"pattern": "^(a+)-\\1$"}`, ""},
+		{`{"patternProperties": {"^(?<!_)[a-z]+$": {"type": "string"}, "^[a-z]+)$": true}}`, "/patternProperties/^[a-z]+)$: invalid regular expression: error parsing regexp: unexpected ): `^[a-z]+)$`"},
+	}
+
+	for _, c := range cases {
+		var document interface{}
+		if err := json.Unmarshal([]byte(c.schema), &document); err != nil {
+			t.Fatalf("invalid test case %s: %s", c.schema, err)
+		}
+
+		err := jsonschema.ValidateDraft07(document)
+		got := ""
+		if err != nil {
+			got = err.Error()
+		}
+		if got != c.want {
+			t.Errorf("ValidateDraft07(%s) = %q, want %q", c.schema, got, c.want)
+		}
+	}
+}
@@ -6,19 +6,21 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
-	//     "github.com/gthesheep/terraform-provider-segment/pkg/data_sources"
+	"github.com/gthesheep/terraform-provider-segment/pkg/data_sources"
 	"github.com/gthesheep/terraform-provider-segment/pkg/resources"
 	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
 )
 
+// Provider returns the provider as built for development, releases are
+// served through New so requests carry the released version.
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"token": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
 				DefaultFunc: schema.EnvDefaultFunc("SEGMENT_API_TOKEN", nil),
-				Description: "Public API token for your Segment account",
+				Description: "Public API token for your Segment account. Changes show up in the audit trail as made by this token, use one only for Terraform to tell them apart from edits in the Segment app",
 			},
 			"api_url": &schema.Schema{
 				Type:        schema.TypeString,
@@ -27,17 +29,82 @@ func Provider() *schema.Provider {
 				Description: "Base Api URL to use, i.e. https://eu1.api.segmentapis.com if your Segment account is hosted in the EU",
 			},
 		},
-		DataSourcesMap: map[string]*schema.Resource{},
+		DataSourcesMap: map[string]*schema.Resource{
+			"segment_audit_events":                 data_sources.DataSourceAuditEvents(),
+			"segment_destination":                  data_sources.DataSourceDestination(),
+			"segment_destination_catalog":          data_sources.DataSourceDestinationCatalog(),
+			"segment_destination_delivery_metrics": data_sources.DataSourceDestinationDeliveryMetrics(),
+			"segment_destinations":                 data_sources.DataSourceDestinations(),
+			"segment_function_versions":            data_sources.DataSourceFunctionVersions(),
+			"segment_labels":                       data_sources.DataSourceLabels(),
+			"segment_regulations":                  data_sources.DataSourceRegulations(),
+			"segment_roles":                        data_sources.DataSourceRoles(),
+			"segment_source":                       data_sources.DataSourceSource(),
+			"segment_source_catalog":               data_sources.DataSourceSourceCatalog(),
+			"segment_sources":                      data_sources.DataSourceSources(),
+			"segment_space":                        data_sources.DataSourceSpace(),
+			"segment_tracking_plan_rule_files":     data_sources.DataSourceTrackingPlanRuleFiles(),
+			"segment_usage":                        data_sources.DataSourceUsage(),
+			"segment_user":                         data_sources.DataSourceUser(),
+			"segment_users":                        data_sources.DataSourceUsers(),
+			"segment_warehouse":                    data_sources.DataSourceWarehouse(),
+			"segment_warehouse_catalog":            data_sources.DataSourceWarehouseCatalog(),
+			"segment_warehouses":                   data_sources.DataSourceWarehouses(),
+		},
 		ResourcesMap: map[string]*schema.Resource{
-			"segment_destination": resources.ResourceDestination(),
-			"segment_source":      resources.ResourceSource(),
-			"segment_warehouse":   resources.ResourceWarehouse(),
+			"segment_destination":                     resources.ResourceDestination(),
+			"segment_source":                          resources.ResourceSource(),
+			"segment_warehouse":                       resources.ResourceWarehouse(),
+			"segment_tracking_plan":                   resources.ResourceTrackingPlan(),
+			"segment_tracking_plan_rules":             resources.ResourceTrackingPlanRules(),
+			"segment_tracking_plan_source_connection": resources.ResourceTrackingPlanSourceConnection(),
+			"segment_label":                           resources.ResourceLabel(),
+			"segment_user_invite":                     resources.ResourceUserInvite(),
+			"segment_user_group":                      resources.ResourceUserGroup(),
+			"segment_user_group_members":              resources.ResourceUserGroupMembers(),
+			"segment_group_permission":                resources.ResourceGroupPermission(),
+			"segment_user_permission":                 resources.ResourceUserPermission(),
+			"segment_function":                        resources.ResourceFunction(),
+			"segment_function_instance":               resources.ResourceFunctionInstance(),
+			"segment_function_deployment":             resources.ResourceFunctionDeployment(),
+			"segment_transformation":                  resources.ResourceTransformation(),
+			"segment_reverse_etl_model":               resources.ResourceReverseETLModel(),
+			"segment_destination_subscription":        resources.ResourceDestinationSubscription(),
+			"segment_space_source_connection":         resources.ResourceSpaceSourceConnection(),
+			"segment_audience":                        resources.ResourceAudience(),
+			"segment_computed_trait":                  resources.ResourceComputedTrait(),
+			"segment_identity_resolution":             resources.ResourceIdentityResolution(),
+			"segment_profiles_sync":                   resources.ResourceProfilesSync(),
+			"segment_regulation":                      resources.ResourceRegulation(),
 		},
-		ConfigureContextFunc: providerConfigure,
+	}
+	p.ConfigureContextFunc = providerConfigure(p, "dev")
+	return p
+}
+
+// New returns a function creating the provider for the given version, which
+// is sent in the User-Agent of every request. The audit trail doesn't keep the
+// User-Agent, changes made by the provider are told apart by the token that
+// made them, see the actor filter of segment_audit_events.
+func New(version string) func() *schema.Provider {
+	return func() *schema.Provider {
+		p := Provider()
+		p.ConfigureContextFunc = providerConfigure(p, version)
+		return p
+	}
+}
+
+func providerConfigure(p *schema.Provider, version string) schema.ConfigureContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		c, diags := configureClient(d)
+		if c != nil {
+			c.UserAgent = p.UserAgent("terraform-provider-segment", version)
+		}
+		return c, diags
 	}
 }
 
-func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+func configureClient(d *schema.ResourceData) (*segment.Client, diag.Diagnostics) {
 
 	token := d.Get("token").(string)
 	apiURL := d.Get("api_url").(string)
@@ -0,0 +1,174 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceAudience() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAudienceCreate,
+		ReadContext:   resourceAudienceRead,
+		UpdateContext: resourceAudienceUpdate,
+		DeleteContext: resourceAudienceDelete,
+
+		Schema: map[string]*schema.Schema{
+			"space_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identifier of the space the audience belongs to",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Descriptive name for the audience",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Description of the audience",
+			},
+			"enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Flag for whether or not the audience is computed",
+			},
+			"query": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Definition of the audience, i.e. `event('Order Completed').within(30 days).count() >= 1`. Brackets and strings are sanity checked at plan time, Segment validates the query itself",
+				ValidateDiagFunc: validateDefinitionQuerySyntax,
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "USERS",
+				Description:  "Type of profiles in the audience, from a list",
+				ValidateFunc: validation.StringInSlice(segment.AudienceTypes, false),
+			},
+			"key": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Key of the audience, as used in traits synced to destinations",
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceAudienceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	spaceID := d.Get("space_id").(string)
+
+	audience, err := c.CreateAudience(spaceID, expandAudience(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(compositeID(spaceID, *audience.ID))
+
+	resourceAudienceRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceAudienceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	spaceID, audienceID, err := splitCompositeID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	audience, err := c.GetAudience(spaceID, audienceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("space_id", spaceID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", audience.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("description", audience.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("enabled", audience.Enabled); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("query", audience.Definition.Query); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("type", audience.Definition.Type); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("key", audience.Key); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceAudienceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	spaceID, audienceID, err := splitCompositeID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("name", "description", "enabled", "query") {
+		_, err := c.UpdateAudience(spaceID, audienceID, expandAudience(d))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceAudienceRead(ctx, d, m)
+}
+
+func resourceAudienceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	spaceID, audienceID, err := splitCompositeID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = c.DeleteAudience(spaceID, audienceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func expandAudience(d *schema.ResourceData) segment.AudienceRequest {
+	return segment.AudienceRequest{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Enabled:     d.Get("enabled").(bool),
+		Definition: segment.AudienceDefinition{
+			Query: d.Get("query").(string),
+			Type:  d.Get("type").(string),
+		},
+	}
+}
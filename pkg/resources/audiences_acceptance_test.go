@@ -0,0 +1,111 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSegmentAudienceResource(t *testing.T) {
+
+	name := strings.ToLower(acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))
+	spaceID := os.Getenv("SEGMENT_SPACE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckSpace(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSegmentAudienceDestroy,
+		Steps: []resource.TestStep{
+			// INVALID DEFINITION
+			{
+				Config:      testAccSegmentAudienceResourceBasicConfig(spaceID, name, "event('Order Completed').count( >= 1"),
+				ExpectError: regexp.MustCompile("Malformed definition query"),
+			},
+			{
+				Config: testAccSegmentAudienceResourceBasicConfig(spaceID, name, "event('Order Completed').count() >= 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentAudienceExists("segment_audience.test_audience"),
+					resource.TestCheckResourceAttr("segment_audience.test_audience", "name", name),
+					resource.TestCheckResourceAttr("segment_audience.test_audience", "type", "USERS"),
+					resource.TestCheckResourceAttrSet("segment_audience.test_audience", "key"),
+				),
+			},
+			// CHANGE DEFINITION
+			{
+				Config: testAccSegmentAudienceResourceBasicConfig(spaceID, name, "event('Order Completed').count() >= 2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentAudienceExists("segment_audience.test_audience"),
+					resource.TestCheckResourceAttr("segment_audience.test_audience", "query", "event('Order Completed').count() >= 2"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "segment_audience.test_audience",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+			},
+		},
+	})
+}
+
+func testAccSegmentAudienceResourceBasicConfig(spaceID, name, query string) string {
+	return fmt.Sprintf(`
+resource "segment_audience" "test_audience" {
+  space_id    = "%s"
+  name        = "%s"
+  description = "Managed by Terraform"
+  enabled     = false
+  query       = "%s"
+}
+`, spaceID, name, query)
+}
+
+func testAccCheckSegmentAudienceExists(resource string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		apiClient := testAccProvider.Meta().(*segment.Client)
+
+		_, err := apiClient.GetAudience(rs.Primary.Attributes["space_id"], strings.TrimPrefix(rs.Primary.ID, rs.Primary.Attributes["space_id"]+":"))
+		if err != nil {
+			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckSegmentAudienceDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*segment.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "segment_audience" {
+			continue
+		}
+		spaceID := rs.Primary.Attributes["space_id"]
+
+		_, err := apiClient.GetAudience(spaceID, strings.TrimPrefix(rs.Primary.ID, spaceID+":"))
+		if err == nil {
+			return fmt.Errorf("Audience still exists")
+		}
+		notFoundErr := "not found"
+		expectedErr := regexp.MustCompile(notFoundErr)
+		if !expectedErr.Match([]byte(err.Error())) {
+			return fmt.Errorf("expected %s, got %s", notFoundErr, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,370 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var computedTraitBlocks = []string{
+	"event_counter",
+	"aggregation",
+	"most_frequent",
+	"sql",
+}
+
+func ResourceComputedTrait() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceComputedTraitCreate,
+		ReadContext:   resourceComputedTraitRead,
+		UpdateContext: resourceComputedTraitUpdate,
+		DeleteContext: resourceComputedTraitDelete,
+
+		Schema: map[string]*schema.Schema{
+			"space_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identifier of the space the computed trait belongs to",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Descriptive name for the computed trait",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Description of the computed trait",
+			},
+			"enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Flag for whether or not the trait is computed",
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "USERS",
+				Description:  "Type of profiles the trait is computed for, from a list. Ignored for SQL traits",
+				ValidateFunc: validation.StringInSlice(segment.AudienceTypes, false),
+			},
+			"event_counter": &schema.Schema{
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				Description:  "Counts the number of times an event was performed",
+				ExactlyOneOf: computedTraitBlocks,
+				Elem: &schema.Resource{
+					Schema: computedTraitEventSchema(),
+				},
+			},
+			"aggregation": &schema.Schema{
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				Description:  "Aggregates a numeric property of an event",
+				ExactlyOneOf: computedTraitBlocks,
+				Elem: &schema.Resource{
+					Schema: computedTraitEventSchema(map[string]*schema.Schema{
+						"property": {
+							Description: "Name of the numeric property to aggregate",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"function": {
+							Description:  "Aggregation to apply, from a list",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(segment.ComputedTraitAggregationFunctions, false),
+						},
+					}),
+				},
+			},
+			"most_frequent": &schema.Schema{
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				Description:  "Returns the most common value of an event property",
+				ExactlyOneOf: computedTraitBlocks,
+				Elem: &schema.Resource{
+					Schema: computedTraitEventSchema(map[string]*schema.Schema{
+						"property": {
+							Description: "Name of the property to find the most common value of",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+					}),
+				},
+			},
+			"sql": &schema.Schema{
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				Description:  "Computes traits from a query run against a warehouse",
+				ExactlyOneOf: computedTraitBlocks,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_id": {
+							Description: "Identifier of the warehouse source to run the query against",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"query": {
+							Description:  "SQL query returning a `user_id` or `anonymous_id` column and a column per trait",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+					},
+				},
+			},
+			"query": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Definition generated from the trait block, as sent to Segment. Changes made outside of Terraform show up as a change to this",
+			},
+			"key": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Key of the computed trait, as it appears on profiles",
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceComputedTraitImport,
+		},
+
+		CustomizeDiff: customizeComputedTraitDiff,
+	}
+}
+
+// computedTraitEventSchema is the schema shared by the event based traits,
+// extended with the fields specific to each type.
+func computedTraitEventSchema(extra ...map[string]*schema.Schema) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"event": {
+			Description: "Name of the event",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"where": {
+			Description:      "Condition the event must match, i.e. `property('total') > 100`. Brackets and strings are sanity checked at plan time, Segment validates the condition itself",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "",
+			ValidateDiagFunc: validateOptionalDefinitionQuerySyntax,
+		},
+		"within_days": {
+			Description:  "Only consider events from this many days ago, all events are considered when 0",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+	}
+	for _, e := range extra {
+		for k, v := range e {
+			s[k] = v
+		}
+	}
+	return s
+}
+
+func resourceComputedTraitImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	spaceID, _, err := splitCompositeID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("space_id", spaceID); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func customizeComputedTraitDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	// The query can't be built from values only known after apply, i.e. an
+	// event name taken from another resource
+	if config := d.GetRawConfig(); !config.IsNull() {
+		for _, key := range append([]string{"type"}, computedTraitBlocks...) {
+			if !config.GetAttr(key).IsWhollyKnown() {
+				return d.SetNewComputed("query")
+			}
+		}
+	}
+
+	_, query := buildComputedTraitDefinition(d)
+	if d.Get("query").(string) != query {
+		return d.SetNew("query", query)
+	}
+	return nil
+}
+
+func resourceComputedTraitCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	spaceID := d.Get("space_id").(string)
+
+	computedTrait, err := c.CreateComputedTrait(spaceID, expandComputedTrait(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(compositeID(spaceID, *computedTrait.ID))
+
+	resourceComputedTraitRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceComputedTraitRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	spaceID, computedTraitID, err := splitCompositeID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	computedTrait, err := c.GetComputedTrait(spaceID, computedTraitID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("space_id", spaceID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", computedTrait.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("description", computedTrait.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("enabled", computedTrait.Enabled); err != nil {
+		return diag.FromErr(err)
+	}
+	if computedTrait.Definition.Type != "SQL" {
+		if err := d.Set("type", computedTrait.Definition.Type); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	// The trait blocks can't be rebuilt from the query, so they're kept as
+	// configured and the query is compared instead
+	if err := d.Set("query", computedTrait.Definition.Query); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("key", computedTrait.Key); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceComputedTraitUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	spaceID, computedTraitID, err := splitCompositeID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("name", "description", "enabled", "query", "event_counter", "aggregation", "most_frequent", "sql") {
+		_, err := c.UpdateComputedTrait(spaceID, computedTraitID, expandComputedTrait(d))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceComputedTraitRead(ctx, d, m)
+}
+
+func resourceComputedTraitDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	spaceID, computedTraitID, err := splitCompositeID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = c.DeleteComputedTrait(spaceID, computedTraitID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func expandComputedTrait(d *schema.ResourceData) segment.ComputedTraitRequest {
+	definition, _ := buildComputedTraitDefinition(d)
+
+	return segment.ComputedTraitRequest{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Enabled:     d.Get("enabled").(bool),
+		Definition:  definition,
+	}
+}
+
+// buildComputedTraitDefinition turns the configured trait block into a
+// definition, it takes anything with Get so it works for diffs and state.
+func buildComputedTraitDefinition(d interface{ Get(string) interface{} }) (segment.ComputedTraitDefinition, string) {
+	profileType := d.Get("type").(string)
+
+	if block := firstBlock(d.Get("sql")); block != nil {
+		query := block["query"].(string)
+		return segment.ComputedTraitDefinition{
+			Query:    query,
+			Type:     "SQL",
+			SourceID: block["source_id"].(string),
+		}, query
+	}
+
+	var query string
+	if block := firstBlock(d.Get("event_counter")); block != nil {
+		query = eventQuery(block) + ".count()"
+	} else if block := firstBlock(d.Get("aggregation")); block != nil {
+		query = fmt.Sprintf("%s.%s(property(%s))", eventQuery(block), block["function"], quoteQueryString(block["property"].(string)))
+	} else if block := firstBlock(d.Get("most_frequent")); block != nil {
+		query = fmt.Sprintf("%s.most_frequent(property(%s))", eventQuery(block), quoteQueryString(block["property"].(string)))
+	}
+
+	return segment.ComputedTraitDefinition{
+		Query: query,
+		Type:  profileType,
+	}, query
+}
+
+func eventQuery(block map[string]interface{}) string {
+	query := fmt.Sprintf("event(%s)", quoteQueryString(block["event"].(string)))
+	if where := strings.TrimSpace(block["where"].(string)); where != "" {
+		query = fmt.Sprintf("%s.where(%s)", query, where)
+	}
+	if withinDays := block["within_days"].(int); withinDays > 0 {
+		query = fmt.Sprintf("%s.within(%d days)", query, withinDays)
+	}
+	return query
+}
+
+func quoteQueryString(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s) + "'"
+}
+
+func firstBlock(v interface{}) map[string]interface{} {
+	blocks, ok := v.([]interface{})
+	if !ok || len(blocks) == 0 || blocks[0] == nil {
+		return nil
+	}
+	return blocks[0].(map[string]interface{})
+}
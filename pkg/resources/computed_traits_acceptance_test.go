@@ -0,0 +1,137 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSegmentComputedTraitResource(t *testing.T) {
+
+	name := strings.ToLower(acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))
+	spaceID := os.Getenv("SEGMENT_SPACE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckSpace(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSegmentComputedTraitDestroy,
+		Steps: []resource.TestStep{
+			// MORE THAN ONE TRAIT BLOCK
+			{
+				Config: testAccSegmentComputedTraitResourceBasicConfig(spaceID, name, `
+  event_counter {
+    event = "Order Completed"
+  }
+  most_frequent {
+    event    = "Order Completed"
+    property = "category"
+  }`),
+				ExpectError: regexp.MustCompile("only one of"),
+			},
+			// INVALID WHERE
+			{
+				Config: testAccSegmentComputedTraitResourceBasicConfig(spaceID, name, `
+  event_counter {
+    event = "Order Completed"
+    where = "property('total') >"
+  }`),
+				ExpectError: regexp.MustCompile("Malformed definition query"),
+			},
+			{
+				Config: testAccSegmentComputedTraitResourceBasicConfig(spaceID, name, `
+  event_counter {
+    event       = "Order Completed"
+    within_days = 30
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentComputedTraitExists("segment_computed_trait.test_computed_trait"),
+					resource.TestCheckResourceAttr("segment_computed_trait.test_computed_trait", "name", name),
+					resource.TestCheckResourceAttr("segment_computed_trait.test_computed_trait", "query", "event('Order Completed').within(30 days).count()"),
+					resource.TestCheckResourceAttrSet("segment_computed_trait.test_computed_trait", "key"),
+				),
+			},
+			// CHANGE TRAIT TYPE
+			{
+				Config: testAccSegmentComputedTraitResourceBasicConfig(spaceID, name, `
+  aggregation {
+    event    = "Order Completed"
+    property = "total"
+    function = "sum"
+    where    = "property('currency') = 'USD'"
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentComputedTraitExists("segment_computed_trait.test_computed_trait"),
+					resource.TestCheckResourceAttr("segment_computed_trait.test_computed_trait", "query", "event('Order Completed').where(property('currency') = 'USD').sum(property('total'))"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "segment_computed_trait.test_computed_trait",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"event_counter", "aggregation", "most_frequent", "sql"},
+			},
+		},
+	})
+}
+
+func testAccSegmentComputedTraitResourceBasicConfig(spaceID, name, definition string) string {
+	return fmt.Sprintf(`
+resource "segment_computed_trait" "test_computed_trait" {
+  space_id    = "%s"
+  name        = "%s"
+  description = "Managed by Terraform"
+  enabled     = false
+%s
+}
+`, spaceID, name, definition)
+}
+
+func testAccCheckSegmentComputedTraitExists(resource string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		apiClient := testAccProvider.Meta().(*segment.Client)
+
+		_, err := apiClient.GetComputedTrait(rs.Primary.Attributes["space_id"], strings.TrimPrefix(rs.Primary.ID, rs.Primary.Attributes["space_id"]+":"))
+		if err != nil {
+			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckSegmentComputedTraitDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*segment.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "segment_computed_trait" {
+			continue
+		}
+		spaceID := rs.Primary.Attributes["space_id"]
+
+		_, err := apiClient.GetComputedTrait(spaceID, strings.TrimPrefix(rs.Primary.ID, spaceID+":"))
+		if err == nil {
+			return fmt.Errorf("Computed trait still exists")
+		}
+		notFoundErr := "not found"
+		expectedErr := regexp.MustCompile(notFoundErr)
+		if !expectedErr.Match([]byte(err.Error())) {
+			return fmt.Errorf("expected %s, got %s", notFoundErr, err)
+		}
+	}
+
+	return nil
+}
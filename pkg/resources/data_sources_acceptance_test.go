@@ -0,0 +1,172 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSegmentObjectDataSources(t *testing.T) {
+
+	name := strings.ToLower(acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))
+	sourceSlug := strings.ToLower(acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))
+	sourceName := strings.ToLower(acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))
+
+	config := testAccSegmentDestinationResourceBasicConfig(sourceSlug, sourceName, "google-tag-manager", name) +
+		testAccSegmentWarehouseResourceBasicConfig(name, "snowflake") +
+		testAccSegmentObjectDataSourcesConfig()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.segment_source.by_slug", "source_id", "segment_source.test_source", "id"),
+					resource.TestCheckResourceAttrPair("data.segment_source.by_name", "source_id", "segment_source.test_source", "id"),
+					resource.TestCheckResourceAttr("data.segment_source.by_slug", "source_slug", "facebook-ads"),
+					resource.TestCheckResourceAttrPair("data.segment_destination.by_name", "destination_id", "segment_destination.test_destination", "id"),
+					resource.TestCheckResourceAttr("data.segment_destination.by_name", "settings.containerId", "xxxx"),
+					resource.TestCheckResourceAttrPair("data.segment_warehouse.by_name", "warehouse_id", "segment_warehouse.test_warehouse", "id"),
+					resource.TestCheckResourceAttr("data.segment_warehouse.by_name", "settings.0.hostname", "www.snowflake.com"),
+					resource.TestCheckResourceAttr("data.segment_sources.filtered", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.segment_sources.filtered", "ids.0", "segment_source.test_source", "id"),
+					resource.TestCheckResourceAttr("data.segment_destinations.filtered", "destinations.#", "1"),
+					resource.TestCheckResourceAttr("data.segment_destinations.filtered", "destinations.0.destination_slug", "google-tag-manager"),
+					resource.TestCheckResourceAttr("data.segment_destinations.enabled", "ids.#", "0"),
+					resource.TestCheckResourceAttr("data.segment_warehouses.filtered", "warehouses.#", "1"),
+					resource.TestCheckResourceAttrPair("data.segment_destination_delivery_metrics.new", "source_id", "segment_source.test_source", "id"),
+					resource.TestCheckResourceAttr("data.segment_destination_delivery_metrics.new", "successes", "0"),
+					resource.TestCheckResourceAttrSet("data.segment_audit_events.source", "events.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSegmentObjectDataSourcesConfig() string {
+	return `
+data "segment_source" "by_slug" {
+  slug = segment_source.test_source.slug
+}
+
+data "segment_source" "by_name" {
+  name = segment_source.test_source.name
+}
+
+data "segment_destination" "by_name" {
+  name      = segment_destination.test_destination.name
+  source_id = segment_source.test_source.id
+}
+
+data "segment_warehouse" "by_name" {
+  name = segment_warehouse.test_warehouse.name
+}
+
+data "segment_sources" "filtered" {
+  source_slug = "facebook-ads"
+  enabled     = false
+  name_regex  = "^${segment_source.test_source.name}$"
+}
+
+data "segment_destinations" "filtered" {
+  source_id  = segment_source.test_source.id
+  name_regex = "^${segment_destination.test_destination.name}$"
+}
+
+data "segment_destinations" "enabled" {
+  source_id = segment_source.test_source.id
+  enabled   = true
+}
+
+data "segment_destination_delivery_metrics" "new" {
+  destination_id = segment_destination.test_destination.id
+}
+
+data "segment_audit_events" "source" {
+  resource_id = segment_source.test_source.id
+}
+
+data "segment_warehouses" "filtered" {
+  warehouse_slug = "snowflake"
+  name_regex     = "^${segment_warehouse.test_warehouse.name}$"
+}
+`
+}
+
+func TestAccSegmentCatalogDataSources(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSegmentCatalogDataSourcesConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.segment_source_catalog.javascript", "slug", "javascript"),
+					resource.TestCheckResourceAttrSet("data.segment_source_catalog.javascript", "metadata_id"),
+					resource.TestCheckResourceAttrPair("data.segment_destination_catalog.by_id", "slug", "data.segment_destination_catalog.google_tag_manager", "slug"),
+					resource.TestCheckResourceAttrSet("data.segment_destination_catalog.google_tag_manager", "options.0.name"),
+					resource.TestCheckResourceAttrSet("data.segment_destination_catalog.google_tag_manager", "logos.0.default"),
+					resource.TestCheckResourceAttr("data.segment_warehouse_catalog.snowflake", "name", "Snowflake"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSegmentCatalogDataSourcesConfig() string {
+	return `
+data "segment_source_catalog" "javascript" {
+  slug = "javascript"
+}
+
+data "segment_destination_catalog" "google_tag_manager" {
+  slug = "google-tag-manager"
+}
+
+data "segment_destination_catalog" "by_id" {
+  metadata_id = data.segment_destination_catalog.google_tag_manager.metadata_id
+}
+
+data "segment_warehouse_catalog" "snowflake" {
+  slug = "snowflake"
+}
+`
+}
+
+func TestAccSegmentUsageDataSource(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSegmentUsageDataSourceConfig(""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.segment_usage.current", "period", regexp.MustCompile(`^\d{4}-\d{2}-01$`)),
+					resource.TestCheckResourceAttrSet("data.segment_usage.current", "total_api_calls"),
+					resource.TestCheckResourceAttrSet("data.segment_usage.current", "daily_by_source.#"),
+				),
+			},
+			// INVALID PERIOD
+			{
+				Config:      testAccSegmentUsageDataSourceConfig(`period = "2022-13"`),
+				ExpectError: regexp.MustCompile("YYYY-MM-DD"),
+			},
+		},
+	})
+}
+
+func testAccSegmentUsageDataSourceConfig(arguments string) string {
+	return fmt.Sprintf(`
+data "segment_usage" "current" {
+  %s
+}
+`, arguments)
+}
@@ -0,0 +1,113 @@
+package resources
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// validateDefinitionQuerySyntax is a sanity check of the syntax of audience
+// and computed trait queries, it catches unbalanced brackets, unterminated
+// strings and dangling operators so they're reported at plan time. Queries
+// aren't parsed, validating them is left to the API.
+func validateDefinitionQuerySyntax(i interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	query, ok := i.(string)
+	if !ok {
+		return diag.Errorf("expected definition query to be a string")
+	}
+
+	if err := checkDefinitionQuery(query); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Malformed definition query",
+			Detail:        fmt.Sprintf("%s\n\n%s\n\nOnly the syntax is checked at plan time, Segment validates the rest of the query", err, query),
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
+type queryBracket struct {
+	char   rune
+	line   int
+	column int
+}
+
+func checkDefinitionQuery(query string) error {
+	if strings.TrimSpace(query) == "" {
+		return fmt.Errorf("query must not be empty")
+	}
+
+	closers := map[rune]rune{')': '(', ']': '[', '}': '{'}
+
+	var stack []queryBracket
+	var quote *queryBracket
+	escaped := false
+	line, column := 1, 0
+	for _, r := range query {
+		column++
+		if r == '\n' {
+			line++
+			column = 0
+		}
+
+		if quote != nil {
+			switch {
+			case escaped:
+				escaped = false
+			case r == '\\':
+				escaped = true
+			case r == quote.char:
+				quote = nil
+			}
+			continue
+		}
+
+		switch r {
+		case '\'', '"':
+			quote = &queryBracket{char: r, line: line, column: column}
+		case '(', '[', '{':
+			stack = append(stack, queryBracket{char: r, line: line, column: column})
+		case ')', ']', '}':
+			if len(stack) == 0 || stack[len(stack)-1].char != closers[r] {
+				return fmt.Errorf("%d:%d: unexpected %q", line, column, r)
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	if quote != nil {
+		return fmt.Errorf("%d:%d: unterminated string", quote.line, quote.column)
+	}
+	if len(stack) > 0 {
+		open := stack[len(stack)-1]
+		return fmt.Errorf("%d:%d: unclosed %q", open.line, open.column, open.char)
+	}
+
+	trimmed := strings.TrimSpace(query)
+	for _, operator := range []string{"&&", "||", "=", "<", ">", ",", ".", "!"} {
+		if strings.HasSuffix(trimmed, operator) {
+			return fmt.Errorf("query ends with %q, the expression is incomplete", operator)
+		}
+	}
+	lower := strings.ToLower(trimmed)
+	for _, operator := range []string{" and", " or", " not"} {
+		if strings.HasSuffix(lower, operator) {
+			return fmt.Errorf("query ends with %q, the expression is incomplete", strings.TrimSpace(operator))
+		}
+	}
+
+	return nil
+}
+
+func validateOptionalDefinitionQuerySyntax(i interface{}, path cty.Path) diag.Diagnostics {
+	if s, ok := i.(string); ok && s == "" {
+		return nil
+	}
+	return validateDefinitionQuerySyntax(i, path)
+}
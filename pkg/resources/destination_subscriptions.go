@@ -0,0 +1,230 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceDestinationSubscription() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDestinationSubscriptionCreate,
+		ReadContext:   resourceDestinationSubscriptionRead,
+		UpdateContext: resourceDestinationSubscriptionUpdate,
+		DeleteContext: resourceDestinationSubscriptionDelete,
+
+		Schema: map[string]*schema.Schema{
+			"destination_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identifier of the actions destination",
+			},
+			"action_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identifier of the destination action the subscription sends data to",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Descriptive name for the subscription",
+			},
+			"enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Flag for whether or not the subscription is enabled",
+			},
+			"trigger": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "FQL expression selecting the events or records to send, i.e. `type = \"track\"` or `type = \"new\"` for Reverse ETL",
+				ValidateDiagFunc: validateFQL,
+				DiffSuppressFunc: suppressEquivalentFQL,
+			},
+			"settings": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "{}",
+				Description:      "Field mappings and settings of the action, as a JSON encoded string",
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+			"model_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "",
+				Description: "Identifier of the `segment_reverse_etl_model` to sync, only for Reverse ETL subscriptions",
+			},
+			"reverse_etl_schedule": &schema.Schema{
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				Description:  "When the model is synced, only for Reverse ETL subscriptions",
+				RequiredWith: []string{"model_id"},
+				Elem: &schema.Resource{
+					Schema: reverseETLScheduleSchema(),
+				},
+			},
+			"action_slug": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Slug of the destination action",
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceDestinationSubscriptionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	destinationID := d.Get("destination_id").(string)
+	subscription, err := expandDestinationSubscription(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	newSubscription, err := c.CreateDestinationSubscription(destinationID, subscription)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(compositeID(destinationID, *newSubscription.ID))
+
+	resourceDestinationSubscriptionRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceDestinationSubscriptionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	destinationID, subscriptionID, err := splitCompositeID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	subscription, err := c.GetDestinationSubscription(destinationID, subscriptionID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	settings, err := json.Marshal(subscription.Settings)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("destination_id", destinationID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("action_id", subscription.ActionID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", subscription.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("enabled", subscription.Enabled); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("trigger", subscription.Trigger); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("settings", string(settings)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("model_id", subscription.ModelID); err != nil {
+		return diag.FromErr(err)
+	}
+	schedule := []interface{}{}
+	if subscription.ReverseETLSchedule != nil {
+		schedule = flattenReverseETLSchedule(subscription.ReverseETLSchedule.Strategy, subscription.ReverseETLSchedule.Config)
+	}
+	if err := d.Set("reverse_etl_schedule", schedule); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("action_slug", subscription.ActionSlug); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceDestinationSubscriptionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	destinationID, subscriptionID, err := splitCompositeID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("name", "enabled", "trigger", "settings", "reverse_etl_schedule") {
+		subscription, err := expandDestinationSubscription(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		_, err = c.UpdateDestinationSubscription(destinationID, subscriptionID, subscription)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDestinationSubscriptionRead(ctx, d, m)
+}
+
+func resourceDestinationSubscriptionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	destinationID, subscriptionID, err := splitCompositeID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = c.DeleteDestinationSubscription(destinationID, subscriptionID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func expandDestinationSubscription(d *schema.ResourceData) (segment.DestinationSubscription, error) {
+	var settings map[string]interface{}
+	if err := json.Unmarshal([]byte(d.Get("settings").(string)), &settings); err != nil {
+		return segment.DestinationSubscription{}, err
+	}
+
+	subscription := segment.DestinationSubscription{
+		Name:     d.Get("name").(string),
+		ActionID: d.Get("action_id").(string),
+		Enabled:  d.Get("enabled").(bool),
+		Trigger:  d.Get("trigger").(string),
+		Settings: settings,
+		ModelID:  d.Get("model_id").(string),
+	}
+	if subscription.ModelID != "" {
+		strategy, config := expandReverseETLSchedule(d.Get("reverse_etl_schedule").([]interface{}))
+		subscription.ReverseETLSchedule = &segment.ReverseETLSchedule{
+			Strategy: strategy,
+			Config:   config,
+		}
+	}
+	return subscription, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -31,9 +32,19 @@ func ResourceDestination() *schema.Resource {
 			},
 			"destination_slug": &schema.Schema{
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				Description:  "Slug for the destination, from a list",
 				ValidateFunc: validation.StringInSlice(segment.DestinationSlugs, false),
+				ExactlyOneOf: []string{"destination_slug", "metadata_id"},
+			},
+			"metadata_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "Identifier of the destination in the catalog, i.e. the `catalog_id` of a `segment_function`",
+				ExactlyOneOf: []string{"destination_slug", "metadata_id"},
 			},
 			"source_id": &schema.Schema{
 				Type:        schema.TypeString,
@@ -51,11 +62,35 @@ func ResourceDestination() *schema.Resource {
 		},
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceDestinationImport,
 		},
 	}
 }
 
+// resourceDestinationImport accepts the ID of the destination, or its name
+// and the slug of its source as name:<destination-name>@<source-slug>.
+func resourceDestinationImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*segment.Client)
+
+	if nameAndSource := strings.TrimPrefix(d.Id(), "name:"); nameAndSource != d.Id() {
+		// Destination names can contain @, source slugs can't
+		i := strings.LastIndex(nameAndSource, "@")
+		if i < 1 || i == len(nameAndSource)-1 {
+			return nil, fmt.Errorf("unexpected format of import ID (%s), expected name:<destination-name>@<source-slug>", d.Id())
+		}
+		source, err := c.GetSourceBySlug(nameAndSource[i+1:])
+		if err != nil {
+			return nil, err
+		}
+		destination, err := c.GetDestinationByName(*source.ID, nameAndSource[:i])
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(*destination.ID)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceDestinationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*segment.Client)
 
@@ -65,9 +100,16 @@ func resourceDestinationCreate(ctx context.Context, d *schema.ResourceData, m in
 	name := d.Get("name").(string)
 	sourceID := d.Get("source_id").(string)
 	destinationSlug := d.Get("destination_slug").(string)
+	metadataID := d.Get("metadata_id").(string)
 	settings := d.Get("settings").(map[string]interface{})
 
-	destination, err := c.CreateDestination(sourceID, enabled, name, destinationSlug, settings)
+	var destination *segment.Destination
+	var err error
+	if metadataID != "" {
+		destination, err = c.CreateDestinationFromMetadata(sourceID, enabled, name, metadataID, settings)
+	} else {
+		destination, err = c.CreateDestination(sourceID, enabled, name, destinationSlug, settings)
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -99,6 +141,9 @@ func resourceDestinationRead(ctx context.Context, d *schema.ResourceData, m inte
 	if err := d.Set("destination_slug", destination.Metadata.Slug); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set("metadata_id", destination.Metadata.ID); err != nil {
+		return diag.FromErr(err)
+	}
 	if err := d.Set("source_id", destination.SourceID); err != nil {
 		return diag.FromErr(err)
 	}
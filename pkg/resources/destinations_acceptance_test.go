@@ -38,6 +38,13 @@ func TestAccSegmentDestinationResource(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{},
 			},
+			// IMPORT BY NAME
+			{
+				ResourceName:      "segment_destination.test_destination",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("name:%s@%s", name, sourceSlug),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -0,0 +1,42 @@
+package resources
+
+import (
+	"fmt"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/fql"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func validateFQL(i interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	expression, ok := i.(string)
+	if !ok {
+		return diag.Errorf("expected FQL expression to be a string")
+	}
+
+	if _, err := fql.Parse(expression); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid FQL expression",
+			Detail:        fmt.Sprintf("%s\n\n%s", err, expression),
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
+func suppressEquivalentFQL(k, old, new string, d *schema.ResourceData) bool {
+	oldNormalized, err := fql.Normalize(old)
+	if err != nil {
+		return false
+	}
+	newNormalized, err := fql.Normalize(new)
+	if err != nil {
+		return false
+	}
+	return oldNormalized == newNormalized
+}
@@ -0,0 +1,33 @@
+package resources
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestFQLDescriptionExamples(t *testing.T) {
+	attributes := []struct {
+		resource  *schema.Resource
+		attribute string
+	}{
+		{ResourceTransformation(), "if"},
+		{ResourceDestinationSubscription(), "trigger"},
+	}
+	examples := regexp.MustCompile("`([^`]+)`")
+
+	for _, a := range attributes {
+		description := a.resource.Schema[a.attribute].Description
+		matches := examples.FindAllStringSubmatch(description, -1)
+		if len(matches) == 0 {
+			t.Errorf("description of %s has no example: %s", a.attribute, description)
+		}
+		for _, match := range matches {
+			if diags := validateFQL(match[1], cty.GetAttrPath(a.attribute)); diags.HasError() {
+				t.Errorf("example %s of %s is invalid: %s", match[1], a.attribute, diags[0].Detail)
+			}
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceFunctionDeployment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFunctionDeploymentCreate,
+		ReadContext:   resourceFunctionDeploymentRead,
+		UpdateContext: resourceFunctionDeploymentUpdate,
+		DeleteContext: resourceFunctionDeploymentDelete,
+
+		Schema: map[string]*schema.Schema{
+			"function_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identifier of the function to deploy",
+			},
+			"version_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Identifier of the version to deploy, from the `segment_function_versions` data source. Changing it to an earlier version rolls the function back, when empty the latest code is deployed. Rolling back replaces the code of the function, so a `segment_function` managing it plans to restore its `code` unless it's updated or ignored with `lifecycle { ignore_changes = [code] }`",
+			},
+			"triggers": &schema.Schema{
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary values that cause a redeploy when changed, i.e. `{ code = segment_function.example.code }`",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"deployed_version_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identifier of the latest version of the function at the time it was deployed",
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceFunctionDeploymentImport,
+		},
+	}
+}
+
+// resourceFunctionDeploymentImport accepts the ID of the function, the
+// latest version is taken to be the deployed one.
+func resourceFunctionDeploymentImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*segment.Client)
+
+	latestID, err := latestFunctionVersionID(c, d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("deployed_version_id", latestID); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceFunctionDeploymentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	functionID := d.Get("function_id").(string)
+
+	if err := deployFunctionVersion(d, m); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(functionID)
+
+	resourceFunctionDeploymentRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceFunctionDeploymentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	functionID := d.Id()
+
+	// Only checks the function still exists, deployments themselves can't
+	// be read back from the API
+	_, err := c.GetFunction(functionID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("function_id", functionID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceFunctionDeploymentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChange("version_id") {
+		if err := deployFunctionVersion(d, m); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceFunctionDeploymentRead(ctx, d, m)
+}
+
+func resourceFunctionDeploymentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Deployed code can't be undeployed, the function is left as it is
+	d.SetId("")
+
+	return diags
+}
+
+func deployFunctionVersion(d *schema.ResourceData, m interface{}) error {
+	c := m.(*segment.Client)
+
+	functionID := d.Get("function_id").(string)
+	versionID := d.Get("version_id").(string)
+
+	if versionID != "" {
+		_, err := c.RestoreFunctionVersion(functionID, versionID)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := c.DeployFunction(functionID)
+	if err != nil {
+		return err
+	}
+
+	latestID, err := latestFunctionVersionID(c, functionID)
+	if err != nil {
+		return err
+	}
+	return d.Set("deployed_version_id", latestID)
+}
+
+func latestFunctionVersionID(c *segment.Client, functionID string) (string, error) {
+	versions, err := c.ListFunctionVersions(functionID)
+	if err != nil {
+		return "", err
+	}
+	if err := segment.SortFunctionVersions(versions); err != nil {
+		return "", err
+	}
+	if len(versions) == 0 {
+		return "", nil
+	}
+	return versions[0].ID, nil
+}
@@ -0,0 +1,142 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceFunctionInstance() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFunctionInstanceCreate,
+		ReadContext:   resourceFunctionInstanceRead,
+		UpdateContext: resourceFunctionInstanceUpdate,
+		DeleteContext: resourceFunctionInstanceDelete,
+
+		Schema: map[string]*schema.Schema{
+			"function_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identifier of the `INSERT_DESTINATION` function to instantiate",
+			},
+			"integration_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identifier of the `segment_destination` or `segment_source` the function runs in front of",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Descriptive name for the function instance",
+			},
+			"enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Flag for whether or not the function instance is enabled",
+			},
+			"settings": &schema.Schema{
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Map containing values for the settings of the function, as strings. Sensitive values aren't returned by the API so changes made outside of Terraform aren't detected",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceFunctionInstanceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	functionID := d.Get("function_id").(string)
+	integrationID := d.Get("integration_id").(string)
+	name := d.Get("name").(string)
+	enabled := d.Get("enabled").(bool)
+	settings := d.Get("settings").(map[string]interface{})
+
+	instance, err := c.CreateFunctionInstance(functionID, integrationID, name, enabled, settings)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s", *instance.ID))
+
+	resourceFunctionInstanceRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceFunctionInstanceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	instanceID := d.Id()
+
+	instance, err := c.GetFunctionInstance(instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("function_id", instance.FunctionID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("integration_id", instance.IntegrationID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", instance.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("enabled", instance.Enabled); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceFunctionInstanceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	instanceID := d.Id()
+
+	if d.HasChange("name") || d.HasChange("enabled") || d.HasChange("settings") {
+		name := d.Get("name").(string)
+		enabled := d.Get("enabled").(bool)
+		settings := d.Get("settings").(map[string]interface{})
+
+		_, err := c.UpdateFunctionInstance(instanceID, name, enabled, settings)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceFunctionInstanceRead(ctx, d, m)
+}
+
+func resourceFunctionInstanceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	instanceID := d.Id()
+
+	_, err := c.DeleteFunctionInstance(instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
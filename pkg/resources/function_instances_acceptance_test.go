@@ -0,0 +1,158 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSegmentFunctionInstanceResource(t *testing.T) {
+
+	name := strings.ToLower(acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))
+	name2 := strings.ToLower(acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))
+	sourceSlug := strings.ToLower(acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSegmentFunctionInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSegmentFunctionInstanceResourceBasicConfig(sourceSlug, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentFunctionInstanceExists("segment_function_instance.test_instance"),
+					resource.TestCheckResourceAttr("segment_function_instance.test_instance", "name", name),
+					resource.TestCheckResourceAttr("segment_function_instance.test_instance", "enabled", "true"),
+					resource.TestCheckResourceAttrSet("segment_function_deployment.test_deployment", "deployed_version_id"),
+				),
+			},
+			// RENAME
+			{
+				Config: testAccSegmentFunctionInstanceResourceBasicConfig(sourceSlug, name2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentFunctionInstanceExists("segment_function_instance.test_instance"),
+					resource.TestCheckResourceAttr("segment_function_instance.test_instance", "name", name2),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "segment_function_instance.test_instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"settings"},
+			},
+			{
+				ResourceName:            "segment_function_deployment.test_deployment",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"triggers"},
+			},
+		},
+	})
+}
+
+func testAccSegmentFunctionInstanceResourceBasicConfig(sourceSlug, name string) string {
+	return fmt.Sprintf(`
+resource "segment_source" "test_source" {
+  slug        = "%s"
+  name        = "%s"
+  source_slug = "facebook-ads"
+  enabled     = false
+  settings {
+    track {
+    }
+    identify {
+    }
+    group {
+    }
+  }
+}
+
+resource "segment_destination" "test_destination" {
+  name             = "%s"
+  destination_slug = "google-tag-manager"
+  enabled          = false
+  source_id        = segment_source.test_source.id
+  settings = {
+    containerId = "xxxx"
+  }
+}
+
+resource "segment_function" "test_function" {
+  display_name  = "%s"
+  resource_type = "INSERT_DESTINATION"
+  code          = "async function onTrack(event, settings) { return event }"
+
+  setting {
+    name      = "apiKey"
+    label     = "API Key"
+    type      = "STRING"
+    sensitive = true
+  }
+}
+
+resource "segment_function_deployment" "test_deployment" {
+  function_id = segment_function.test_function.id
+  triggers = {
+    code = segment_function.test_function.code
+  }
+}
+
+resource "segment_function_instance" "test_instance" {
+  function_id    = segment_function_deployment.test_deployment.function_id
+  integration_id = segment_destination.test_destination.id
+  name           = "%s"
+  settings = {
+    apiKey = "xxxx"
+  }
+}
+`, sourceSlug, sourceSlug, sourceSlug, sourceSlug, name)
+}
+
+func testAccCheckSegmentFunctionInstanceExists(resource string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		apiClient := testAccProvider.Meta().(*segment.Client)
+
+		_, err := apiClient.GetFunctionInstance(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckSegmentFunctionInstanceDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*segment.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "segment_function_instance" {
+			continue
+		}
+		instanceID := rs.Primary.ID
+
+		_, err := apiClient.GetFunctionInstance(instanceID)
+		if err == nil {
+			return fmt.Errorf("Function instance still exists")
+		}
+		notFoundErr := "not found"
+		expectedErr := regexp.MustCompile(notFoundErr)
+		if !expectedErr.Match([]byte(err.Error())) {
+			return fmt.Errorf("expected %s, got %s", notFoundErr, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,244 @@
+package resources
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceFunction() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFunctionCreate,
+		ReadContext:   resourceFunctionRead,
+		UpdateContext: resourceFunctionUpdate,
+		DeleteContext: resourceFunctionDelete,
+
+		Schema: map[string]*schema.Schema{
+			"display_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Descriptive name for the function, shown in the catalog",
+			},
+			"resource_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Type of the function, from a list",
+				ValidateFunc: validation.StringInSlice(segment.FunctionResourceTypes, false),
+			},
+			"code": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "JavaScript source of the function, i.e. `file(\"${path.module}/functions/enrich.js\")`. Only a SHA-256 hash of the code is kept in state. Rolling back with `segment_function_deployment` replaces the code",
+				StateFunc:   hashFunctionCode,
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Description of the function",
+			},
+			"logo_url": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "URL of the logo shown for the function in the catalog",
+			},
+			"setting": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Settings that instances of the function must or can provide",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "Name of the setting, as used in the function code",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"label": {
+							Description: "Label of the setting, shown in the UI",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"type": {
+							Description:  "Type of the setting, from a list",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(segment.FunctionSettingTypes, false),
+						},
+						"description": {
+							Description: "Description of the setting",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "",
+						},
+						"required": {
+							Description: "Flag for whether or not instances must provide the setting",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+						"sensitive": {
+							Description: "Flag for whether or not the setting holds a secret, which is hidden once saved",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+					},
+				},
+			},
+			"catalog_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identifier of the function in the catalog, used as the `metadata_id` of sources and destinations running the function",
+			},
+			"preview_webhook_url": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Webhook URL for sending test events to source functions",
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	function, err := c.CreateFunction(expandFunction(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s", *function.ID))
+
+	resourceFunctionRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	functionID := d.Id()
+
+	function, err := c.GetFunction(functionID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("display_name", function.DisplayName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("resource_type", function.ResourceType); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("code", hashFunctionCode(function.Code)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("description", function.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("logo_url", function.LogoURL); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("setting", flattenFunctionSettings(function.Settings)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("catalog_id", function.CatalogID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("preview_webhook_url", function.PreviewWebhookURL); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	functionID := d.Id()
+
+	if d.HasChange("display_name") || d.HasChange("code") || d.HasChange("description") || d.HasChange("logo_url") || d.HasChange("setting") {
+		_, err := c.UpdateFunction(functionID, expandFunction(d))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceFunctionRead(ctx, d, m)
+}
+
+func resourceFunctionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	functionID := d.Id()
+
+	_, err := c.DeleteFunction(functionID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func expandFunction(d *schema.ResourceData) segment.FunctionRequest {
+	var settings []segment.FunctionSetting
+	for _, s := range d.Get("setting").([]interface{}) {
+		setting := s.(map[string]interface{})
+		settings = append(settings, segment.FunctionSetting{
+			Name:        setting["name"].(string),
+			Label:       setting["label"].(string),
+			Description: setting["description"].(string),
+			Type:        setting["type"].(string),
+			Required:    setting["required"].(bool),
+			Sensitive:   setting["sensitive"].(bool),
+		})
+	}
+
+	return segment.FunctionRequest{
+		DisplayName:  d.Get("display_name").(string),
+		Description:  d.Get("description").(string),
+		LogoURL:      d.Get("logo_url").(string),
+		Code:         d.Get("code").(string),
+		Settings:     settings,
+		ResourceType: d.Get("resource_type").(string),
+	}
+}
+
+func flattenFunctionSettings(settings []segment.FunctionSetting) []interface{} {
+	flatSettings := make([]interface{}, 0, len(settings))
+	for _, setting := range settings {
+		flatSettings = append(flatSettings, map[string]interface{}{
+			"name":        setting.Name,
+			"label":       setting.Label,
+			"description": setting.Description,
+			"type":        setting.Type,
+			"required":    setting.Required,
+			"sensitive":   setting.Sensitive,
+		})
+	}
+	return flatSettings
+}
+
+// hashFunctionCode keeps function code out of state, changes to the code
+// still show up as a change to the hash.
+func hashFunctionCode(v interface{}) string {
+	sum := sha256.Sum256([]byte(v.(string)))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,117 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSegmentFunctionResource(t *testing.T) {
+
+	name := strings.ToLower(acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))
+	name2 := strings.ToLower(acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSegmentFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSegmentFunctionResourceBasicConfig(name, "event.properties.checked = true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentFunctionExists("segment_function.test_function"),
+					resource.TestCheckResourceAttr("segment_function.test_function", "display_name", name),
+					resource.TestCheckResourceAttr("segment_function.test_function", "resource_type", "DESTINATION"),
+					resource.TestCheckResourceAttr("segment_function.test_function", "setting.#", "1"),
+					resource.TestCheckResourceAttrSet("segment_function.test_function", "catalog_id"),
+				),
+			},
+			// RENAME AND CHANGE CODE
+			{
+				Config: testAccSegmentFunctionResourceBasicConfig(name2, "event.properties.checked = false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentFunctionExists("segment_function.test_function"),
+					resource.TestCheckResourceAttr("segment_function.test_function", "display_name", name2),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "segment_function.test_function",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+			},
+		},
+	})
+}
+
+func testAccSegmentFunctionResourceBasicConfig(name, statement string) string {
+	return fmt.Sprintf(`
+resource "segment_function" "test_function" {
+  display_name  = "%s"
+  resource_type = "DESTINATION"
+  description   = "Managed by Terraform"
+  code          = <<-EOT
+    async function onTrack(event, settings) {
+      %s
+      await fetch(settings.url, { method: 'POST', body: JSON.stringify(event) })
+    }
+  EOT
+
+  setting {
+    name     = "url"
+    label    = "URL"
+    type     = "STRING"
+    required = true
+  }
+}
+`, name, statement)
+}
+
+func testAccCheckSegmentFunctionExists(resource string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		apiClient := testAccProvider.Meta().(*segment.Client)
+
+		_, err := apiClient.GetFunction(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckSegmentFunctionDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*segment.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "segment_function" {
+			continue
+		}
+		functionID := rs.Primary.ID
+
+		_, err := apiClient.GetFunction(functionID)
+		if err == nil {
+			return fmt.Errorf("Function still exists")
+		}
+		notFoundErr := "not found"
+		expectedErr := regexp.MustCompile(notFoundErr)
+		if !expectedErr.Match([]byte(err.Error())) {
+			return fmt.Errorf("expected %s, got %s", notFoundErr, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package resources
+
+import (
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceGroupPermission() *schema.Resource {
+	return permissionsResource(permissionsHolder{
+		idKey:                 "group_id",
+		idDescription:         "Identifier of the user group",
+		permissionDescription: "Permissions granted to members of the group, this resource manages every permission of the group",
+		get: func(c *segment.Client, userGroupID string) ([]segment.Permission, error) {
+			userGroup, err := c.GetUserGroup(userGroupID)
+			if err != nil {
+				return nil, err
+			}
+			return userGroup.Permissions, nil
+		},
+		replace: (*segment.Client).ReplaceUserGroupPermissions,
+	})
+}
@@ -0,0 +1,88 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSegmentGroupPermissionResource(t *testing.T) {
+
+	name := strings.ToLower(acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))
+	workspaceID := os.Getenv("SEGMENT_WORKSPACE_ID")
+	roleID := os.Getenv("SEGMENT_ROLE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckPermissions(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSegmentUserGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSegmentGroupPermissionResourceBasicConfig(name, roleID, workspaceID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentGroupPermissionExists("segment_group_permission.test_permission"),
+					resource.TestCheckResourceAttr("segment_group_permission.test_permission", "permission.#", "1"),
+					resource.TestCheckResourceAttr("segment_group_permission.test_permission", "permission.0.role_id", roleID),
+					resource.TestCheckResourceAttr("segment_group_permission.test_permission", "permission.0.resource.0.id", workspaceID),
+					resource.TestCheckResourceAttrSet("data.segment_roles.all", "roles.0.id"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "segment_group_permission.test_permission",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+			},
+		},
+	})
+}
+
+func testAccSegmentGroupPermissionResourceBasicConfig(name, roleID, workspaceID string) string {
+	return fmt.Sprintf(`
+data "segment_roles" "all" {}
+
+resource "segment_user_group" "test_group" {
+  name = "%s"
+}
+
+resource "segment_group_permission" "test_permission" {
+  group_id = segment_user_group.test_group.id
+  permission {
+    role_id = "%s"
+    resource {
+      id   = "%s"
+      type = "WORKSPACE"
+    }
+  }
+}
+`, name, roleID, workspaceID)
+}
+
+func testAccCheckSegmentGroupPermissionExists(resource string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		apiClient := testAccProvider.Meta().(*segment.Client)
+
+		userGroup, err := apiClient.GetUserGroup(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
+		}
+		if len(userGroup.Permissions) == 0 {
+			return fmt.Errorf("No permissions granted to group %s", rs.Primary.ID)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,21 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func expandStringSet(set *schema.Set) []string {
+	strs := make([]string, 0, set.Len())
+	for _, v := range set.List() {
+		strs = append(strs, v.(string))
+	}
+	return strs
+}
+
+func expandStringList(list []interface{}) []string {
+	strs := make([]string, 0, len(list))
+	for _, v := range list {
+		strs = append(strs, v.(string))
+	}
+	return strs
+}
@@ -0,0 +1,263 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIdentityResolution() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIdentityResolutionCreate,
+		ReadContext:   resourceIdentityResolutionRead,
+		UpdateContext: resourceIdentityResolutionUpdate,
+		DeleteContext: resourceIdentityResolutionDelete,
+
+		Schema: map[string]*schema.Schema{
+			"space_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identifier of the space to configure identity resolution for",
+			},
+			"identifier": &schema.Schema{
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "External ID types used to merge profiles, in priority order with the highest priority first",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Description:  "External ID type, i.e. `user_id`, `email` or a custom type",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"limit": {
+							Description:  "Maximum number of values of this type a profile can hold",
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"frequency": {
+							Description:  "Period the limit applies over, from a list",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "EVER",
+							ValidateFunc: validation.StringInSlice(segment.IdentifierLimitFrequencies, false),
+						},
+					},
+				},
+			},
+			"allow_irreversible_changes": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Allow changes to existing identifiers, profiles merged under the new rules can't be unmerged",
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIdentityResolutionImport,
+		},
+
+		CustomizeDiff: customizeIdentityResolutionDiff,
+	}
+}
+
+func resourceIdentityResolutionImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set("space_id", d.Id()); err != nil {
+		return nil, err
+	}
+	if err := d.Set("allow_irreversible_changes", false); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// customizeIdentityResolutionDiff refuses to plan changes to identifiers the
+// space already uses unless they've been explicitly allowed, listing each one
+// as the plan output alone makes reordering hard to spot.
+func customizeIdentityResolutionDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("identifier") {
+		return nil
+	}
+	identifiers := expandIdentifiers(d.Get("identifier").([]interface{}))
+
+	seen := make(map[string]bool, len(identifiers))
+	for _, identifier := range identifiers {
+		if seen[identifier.Key] {
+			return fmt.Errorf("identifier %q is listed more than once", identifier.Key)
+		}
+		seen[identifier.Key] = true
+	}
+
+	if d.Get("allow_irreversible_changes").(bool) {
+		return nil
+	}
+
+	var current []segment.IdentityResolutionIdentifier
+	if d.Id() == "" {
+		// The space always has a configuration, creating the resource replaces it
+		if !d.NewValueKnown("space_id") {
+			return nil
+		}
+		c := m.(*segment.Client)
+		identityResolution, err := c.GetIdentityResolution(d.Get("space_id").(string))
+		if err != nil {
+			return err
+		}
+		current = identityResolution.Identifiers
+	} else {
+		if !d.HasChange("identifier") {
+			return nil
+		}
+		o, _ := d.GetChange("identifier")
+		current = expandIdentifiers(o.([]interface{}))
+	}
+
+	changes := describeIdentifierChanges(current, identifiers)
+	if len(changes) > 0 {
+		return fmt.Errorf("changes to identity resolution can't be undone once profiles have been merged, set allow_irreversible_changes to apply them:\n  %s", strings.Join(changes, "\n  "))
+	}
+
+	return nil
+}
+
+func resourceIdentityResolutionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	spaceID := d.Get("space_id").(string)
+
+	_, err := c.ReplaceIdentityResolution(spaceID, expandIdentifiers(d.Get("identifier").([]interface{})))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(spaceID)
+
+	resourceIdentityResolutionRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceIdentityResolutionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	spaceID := d.Id()
+
+	identityResolution, err := c.GetIdentityResolution(spaceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("space_id", spaceID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("identifier", flattenIdentifiers(identityResolution.Identifiers)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceIdentityResolutionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	spaceID := d.Id()
+
+	if d.HasChange("identifier") {
+		_, err := c.ReplaceIdentityResolution(spaceID, expandIdentifiers(d.Get("identifier").([]interface{})))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIdentityResolutionRead(ctx, d, m)
+}
+
+func resourceIdentityResolutionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// A space can't be left without identity resolution, so the settings are
+	// left as they are
+	d.SetId("")
+
+	return diags
+}
+
+func expandIdentifiers(identifiers []interface{}) []segment.IdentityResolutionIdentifier {
+	expanded := make([]segment.IdentityResolutionIdentifier, 0, len(identifiers))
+	for i, id := range identifiers {
+		identifier := id.(map[string]interface{})
+		expanded = append(expanded, segment.IdentityResolutionIdentifier{
+			Key:       identifier["type"].(string),
+			Limit:     identifier["limit"].(int),
+			Frequency: identifier["frequency"].(string),
+			Priority:  i + 1,
+		})
+	}
+	return expanded
+}
+
+func flattenIdentifiers(identifiers []segment.IdentityResolutionIdentifier) []interface{} {
+	sorted := make([]segment.IdentityResolutionIdentifier, len(identifiers))
+	copy(sorted, identifiers)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Priority < sorted[j].Priority
+	})
+
+	flatIdentifiers := make([]interface{}, 0, len(sorted))
+	for _, identifier := range sorted {
+		flatIdentifiers = append(flatIdentifiers, map[string]interface{}{
+			"type":      identifier.Key,
+			"limit":     identifier.Limit,
+			"frequency": identifier.Frequency,
+		})
+	}
+	return flatIdentifiers
+}
+
+// describeIdentifierChanges lists the changes made to identifiers in current,
+// adding a new identifier with the lowest priority doesn't affect existing
+// merges so it isn't reported.
+func describeIdentifierChanges(current, planned []segment.IdentityResolutionIdentifier) []string {
+	plannedByKey := make(map[string]segment.IdentityResolutionIdentifier, len(planned))
+	for _, identifier := range planned {
+		plannedByKey[identifier.Key] = identifier
+	}
+
+	sorted := make([]segment.IdentityResolutionIdentifier, len(current))
+	copy(sorted, current)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Priority < sorted[j].Priority
+	})
+
+	var changes []string
+	for _, old := range sorted {
+		identifier, ok := plannedByKey[old.Key]
+		if !ok {
+			changes = append(changes, fmt.Sprintf("%s: removed", old.Key))
+			continue
+		}
+		if old.Priority != identifier.Priority {
+			changes = append(changes, fmt.Sprintf("%s: priority %d -> %d", old.Key, old.Priority, identifier.Priority))
+		}
+		if old.Limit != identifier.Limit {
+			changes = append(changes, fmt.Sprintf("%s: limit %d -> %d", old.Key, old.Limit, identifier.Limit))
+		}
+		if old.Frequency != identifier.Frequency {
+			changes = append(changes, fmt.Sprintf("%s: frequency %s -> %s", old.Key, old.Frequency, identifier.Frequency))
+		}
+	}
+	return changes
+}
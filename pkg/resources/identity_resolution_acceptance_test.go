@@ -0,0 +1,74 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSegmentIdentityResolutionResource(t *testing.T) {
+
+	spaceID := os.Getenv("SEGMENT_SPACE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckSpace(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			// The space already has settings, so taking them over has to be allowed
+			{
+				Config: testAccSegmentIdentityResolutionResourceBasicConfig(spaceID, "user_id", "email", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("segment_identity_resolution.test_identity_resolution", "identifier.#", "3"),
+					resource.TestCheckResourceAttr("segment_identity_resolution.test_identity_resolution", "identifier.0.type", "user_id"),
+					resource.TestCheckResourceAttr("segment_identity_resolution.test_identity_resolution", "identifier.1.frequency", "EVER"),
+				),
+			},
+			// REORDER WITHOUT ALLOWING IT
+			{
+				Config:      testAccSegmentIdentityResolutionResourceBasicConfig(spaceID, "email", "user_id", false),
+				ExpectError: regexp.MustCompile(`user_id: priority 1 -> 2`),
+			},
+			// REORDER
+			{
+				Config: testAccSegmentIdentityResolutionResourceBasicConfig(spaceID, "email", "user_id", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("segment_identity_resolution.test_identity_resolution", "identifier.0.type", "email"),
+					resource.TestCheckResourceAttr("segment_identity_resolution.test_identity_resolution", "identifier.1.type", "user_id"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "segment_identity_resolution.test_identity_resolution",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"allow_irreversible_changes"},
+			},
+		},
+	})
+}
+
+func testAccSegmentIdentityResolutionResourceBasicConfig(spaceID, first, second string, allow bool) string {
+	return fmt.Sprintf(`
+resource "segment_identity_resolution" "test_identity_resolution" {
+  space_id                   = "%s"
+  allow_irreversible_changes = %t
+
+  identifier {
+    type  = "%s"
+    limit = 1
+  }
+  identifier {
+    type  = "%s"
+    limit = 5
+  }
+  identifier {
+    type      = "anonymous_id"
+    limit     = 5
+    frequency = "WEEKLY"
+  }
+}
+`, spaceID, allow, first, second)
+}
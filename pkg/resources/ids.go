@@ -0,0 +1,20 @@
+package resources
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Resources that only exist in the context of another object, such as a
+// connection between two objects, use IDs of the form "<parent>:<child>".
+func compositeID(parentID string, childID string) string {
+	return fmt.Sprintf("%s:%s", parentID, childID)
+}
+
+func splitCompositeID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected <parent_id>:<id>", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,116 @@
+package resources
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceLabel() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLabelCreate,
+		ReadContext:   resourceLabelRead,
+		DeleteContext: resourceLabelDelete,
+
+		Schema: map[string]*schema.Schema{
+			"key": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Key of the label, i.e. environment",
+				ValidateFunc: validation.StringDoesNotContainAny(":"),
+			},
+			"value": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Value of the label, i.e. production",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "",
+				Description: "Description of the label",
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceLabelCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	key := d.Get("key").(string)
+	value := d.Get("value").(string)
+	description := d.Get("description").(string)
+
+	label, err := c.CreateLabel(key, value, description)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(compositeID(label.Key, label.Value))
+
+	resourceLabelRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceLabelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	key, value, err := splitCompositeID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	label, err := c.GetLabel(key, value)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("key", label.Key); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("value", label.Value); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("description", label.Description); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceLabelDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	key, value, err := splitCompositeID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = c.DeleteLabel(key, value)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
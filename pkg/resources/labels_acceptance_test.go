@@ -0,0 +1,103 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSegmentLabelResource(t *testing.T) {
+
+	key := strings.ToLower(acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))
+	value := strings.ToLower(acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))
+	value2 := strings.ToLower(acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSegmentLabelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSegmentLabelResourceBasicConfig(key, value),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentLabelExists("segment_label.test_label"),
+					resource.TestCheckResourceAttr("segment_label.test_label", "key", key),
+					resource.TestCheckResourceAttr("segment_label.test_label", "value", value),
+					resource.TestCheckResourceAttr("segment_label.test_label", "description", "Managed by Terraform"),
+				),
+			},
+			// CHANGE VALUE
+			{
+				Config: testAccSegmentLabelResourceBasicConfig(key, value2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentLabelExists("segment_label.test_label"),
+					resource.TestCheckResourceAttr("segment_label.test_label", "value", value2),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "segment_label.test_label",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+			},
+		},
+	})
+}
+
+func testAccSegmentLabelResourceBasicConfig(key, value string) string {
+	return fmt.Sprintf(`
+resource "segment_label" "test_label" {
+  key         = "%s"
+  value       = "%s"
+  description = "Managed by Terraform"
+}
+`, key, value)
+}
+
+func testAccCheckSegmentLabelExists(resource string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		apiClient := testAccProvider.Meta().(*segment.Client)
+
+		_, err := apiClient.GetLabel(rs.Primary.Attributes["key"], rs.Primary.Attributes["value"])
+		if err != nil {
+			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckSegmentLabelDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*segment.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "segment_label" {
+			continue
+		}
+
+		_, err := apiClient.GetLabel(rs.Primary.Attributes["key"], rs.Primary.Attributes["value"])
+		if err == nil {
+			return fmt.Errorf("Label still exists")
+		}
+		notFoundErr := "not found"
+		expectedErr := regexp.MustCompile(notFoundErr)
+		if !expectedErr.Match([]byte(err.Error())) {
+			return fmt.Errorf("expected %s, got %s", notFoundErr, err)
+		}
+	}
+
+	return nil
+}
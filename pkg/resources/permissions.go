@@ -0,0 +1,344 @@
+package resources
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// permissionsHolder describes what permissions are granted to, either a user
+// or a user group, for the resources managing all of its permissions.
+type permissionsHolder struct {
+	idKey                 string
+	idDescription         string
+	permissionDescription string
+	get                   func(c *segment.Client, id string) ([]segment.Permission, error)
+	replace               func(c *segment.Client, id string, permissions []segment.Permission) error
+}
+
+func permissionsResource(holder permissionsHolder) *schema.Resource {
+	return &schema.Resource{
+		CreateContext: holder.create,
+		ReadContext:   holder.read,
+		UpdateContext: holder.update,
+		DeleteContext: holder.delete,
+
+		Schema: map[string]*schema.Schema{
+			holder.idKey: &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: holder.idDescription,
+			},
+			"permission": &schema.Schema{
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: holder.permissionDescription,
+				Elem: &schema.Resource{
+					Schema: permissionSchema(),
+				},
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: holder.importState,
+		},
+	}
+}
+
+func (holder permissionsHolder) importState(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set(holder.idKey, d.Id()); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func (holder permissionsHolder) create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	id := d.Get(holder.idKey).(string)
+	permissions := mapToPermissions(d.Get("permission").([]interface{}))
+
+	err := holder.replace(c, id, permissions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id)
+
+	holder.read(ctx, d, m)
+
+	return diags
+}
+
+func (holder permissionsHolder) read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	id := d.Id()
+
+	permissions, err := holder.get(c, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	sortPermissionsLike(permissions, mapToPermissions(d.Get("permission").([]interface{})))
+
+	if err := d.Set(holder.idKey, id); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("permission", flattenPermissions(permissions)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func (holder permissionsHolder) update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	if d.HasChange("permission") {
+		permissions := mapToPermissions(d.Get("permission").([]interface{}))
+
+		err := holder.replace(c, d.Id(), permissions)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return holder.read(ctx, d, m)
+}
+
+func (holder permissionsHolder) delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	err := holder.replace(c, d.Id(), []segment.Permission{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func permissionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"role_id": {
+			Description: "Identifier of the role to grant",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"resource": {
+			Description: "Resources the role is granted on",
+			Type:        schema.TypeList,
+			Required:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Description: "Identifier of the workspace, source or space",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"type": {
+						Description:  "Type of the resource, from a list",
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(segment.PermissionResourceTypes, false),
+					},
+					"label": {
+						Description: "Labels to restrict the grant to, only valid on WORKSPACE resources",
+						Type:        schema.TypeList,
+						Optional:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"key": {
+									Description: "Key of the label",
+									Type:        schema.TypeString,
+									Required:    true,
+								},
+								"value": {
+									Description: "Value of the label",
+									Type:        schema.TypeString,
+									Required:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func mapToPermissions(permissions []interface{}) []segment.Permission {
+	actualPermissions := make([]segment.Permission, 0, len(permissions))
+	for _, p := range permissions {
+		permission := p.(map[string]interface{})
+
+		resources := permission["resource"].([]interface{})
+		permissionResources := make([]segment.PermissionResource, 0, len(resources))
+		for _, r := range resources {
+			resource := r.(map[string]interface{})
+
+			var labels []segment.Label
+			for _, l := range resource["label"].([]interface{}) {
+				label := l.(map[string]interface{})
+				labels = append(labels, segment.Label{
+					Key:   label["key"].(string),
+					Value: label["value"].(string),
+				})
+			}
+
+			permissionResources = append(permissionResources, segment.PermissionResource{
+				ID:     resource["id"].(string),
+				Type:   resource["type"].(string),
+				Labels: labels,
+			})
+		}
+
+		actualPermissions = append(actualPermissions, segment.Permission{
+			RoleID:    permission["role_id"].(string),
+			Resources: permissionResources,
+		})
+	}
+	return actualPermissions
+}
+
+func flattenPermissions(permissions []segment.Permission) []interface{} {
+	flatPermissions := make([]interface{}, 0, len(permissions))
+	for _, permission := range permissions {
+		resources := make([]interface{}, 0, len(permission.Resources))
+		for _, resource := range permission.Resources {
+			labels := make([]interface{}, 0, len(resource.Labels))
+			for _, label := range resource.Labels {
+				labels = append(labels, map[string]interface{}{
+					"key":   label.Key,
+					"value": label.Value,
+				})
+			}
+			resources = append(resources, map[string]interface{}{
+				"id":    resource.ID,
+				"type":  resource.Type,
+				"label": labels,
+			})
+		}
+		flatPermissions = append(flatPermissions, map[string]interface{}{
+			"role_id":  permission.RoleID,
+			"resource": resources,
+		})
+	}
+	return flatPermissions
+}
+
+// sortPermissionsLike orders permissions returned by the API to match the
+// order they were configured in, as the API doesn't preserve it. The
+// resources and labels of configured permissions are put back in order too.
+// Anything that isn't configured is kept at the end in the order returned.
+func sortPermissionsLike(permissions []segment.Permission, configured []segment.Permission) {
+	configuredByIdentity := make(map[string]segment.Permission, len(configured))
+	identities := make([]string, 0, len(configured))
+	for _, permission := range configured {
+		identity := permissionIdentity(permission)
+		configuredByIdentity[identity] = permission
+		identities = append(identities, identity)
+	}
+	sortLike(len(permissions), func(i int) string { return permissionIdentity(permissions[i]) }, identities, func(i, j int) {
+		permissions[i], permissions[j] = permissions[j], permissions[i]
+	})
+
+	for _, permission := range permissions {
+		configuredPermission, ok := configuredByIdentity[permissionIdentity(permission)]
+		if !ok {
+			continue
+		}
+		sortPermissionResourcesLike(permission.Resources, configuredPermission.Resources)
+	}
+}
+
+func sortPermissionResourcesLike(resources []segment.PermissionResource, configured []segment.PermissionResource) {
+	configuredByIdentity := make(map[string]segment.PermissionResource, len(configured))
+	identities := make([]string, 0, len(configured))
+	for _, resource := range configured {
+		identity := permissionResourceIdentity(resource)
+		configuredByIdentity[identity] = resource
+		identities = append(identities, identity)
+	}
+	sortLike(len(resources), func(i int) string { return permissionResourceIdentity(resources[i]) }, identities, func(i, j int) {
+		resources[i], resources[j] = resources[j], resources[i]
+	})
+
+	for _, resource := range resources {
+		configuredResource, ok := configuredByIdentity[permissionResourceIdentity(resource)]
+		if !ok {
+			continue
+		}
+		labels := resource.Labels
+		identities := make([]string, 0, len(configuredResource.Labels))
+		for _, label := range configuredResource.Labels {
+			identities = append(identities, labelIdentity(label))
+		}
+		sortLike(len(labels), func(i int) string { return labelIdentity(labels[i]) }, identities, func(i, j int) {
+			labels[i], labels[j] = labels[j], labels[i]
+		})
+	}
+}
+
+// sortLike stably orders n items by the position of their identity in
+// configured, items that aren't configured go last.
+func sortLike(n int, identity func(i int) string, configured []string, swap func(i, j int)) {
+	position := make(map[string]int, len(configured))
+	for i, id := range configured {
+		position[id] = i
+	}
+	positions := make([]int, n)
+	for i := range positions {
+		p, ok := position[identity(i)]
+		if !ok {
+			p = len(configured)
+		}
+		positions[i] = p
+	}
+	sort.Stable(likeSorter{positions: positions, swap: swap})
+}
+
+// likeSorter sorts positions, swapping the caller's items along with them.
+type likeSorter struct {
+	positions []int
+	swap      func(i, j int)
+}
+
+func (s likeSorter) Len() int           { return len(s.positions) }
+func (s likeSorter) Less(i, j int) bool { return s.positions[i] < s.positions[j] }
+func (s likeSorter) Swap(i, j int) {
+	s.positions[i], s.positions[j] = s.positions[j], s.positions[i]
+	s.swap(i, j)
+}
+
+func permissionIdentity(permission segment.Permission) string {
+	ids := make([]string, 0, len(permission.Resources))
+	for _, resource := range permission.Resources {
+		ids = append(ids, permissionResourceIdentity(resource))
+	}
+	sort.Strings(ids)
+	return permission.RoleID + ":" + strings.Join(ids, ",")
+}
+
+func permissionResourceIdentity(resource segment.PermissionResource) string {
+	labels := make([]string, 0, len(resource.Labels))
+	for _, label := range resource.Labels {
+		labels = append(labels, labelIdentity(label))
+	}
+	sort.Strings(labels)
+	return resource.Type + "/" + resource.ID + "[" + strings.Join(labels, ",") + "]"
+}
+
+func labelIdentity(label segment.Label) string {
+	return label.Key + "=" + label.Value
+}
@@ -0,0 +1,72 @@
+package resources
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+)
+
+func TestSortPermissionsLikeWithLabels(t *testing.T) {
+	configured := mapToPermissions([]interface{}{
+		flatPermission("role", flatResource("ws", "WORKSPACE", "env", "prod", "team", "data"), flatResource("src", "SOURCE")),
+		flatPermission("role", flatResource("ws", "WORKSPACE", "env", "dev", "team", "data")),
+	})
+
+	returned := []segment.Permission{
+		{
+			RoleID: "role",
+			Resources: []segment.PermissionResource{
+				{ID: "ws", Type: "WORKSPACE", Labels: []segment.Label{{Key: "team", Value: "data"}, {Key: "env", Value: "dev"}}},
+			},
+		},
+		{
+			RoleID: "role",
+			Resources: []segment.PermissionResource{
+				{ID: "ws", Type: "WORKSPACE", Labels: []segment.Label{{Key: "env", Value: "staging"}}},
+			},
+		},
+		// Resource and label order from the API doesn't matter
+		{
+			RoleID: "role",
+			Resources: []segment.PermissionResource{
+				{ID: "src", Type: "SOURCE"},
+				{ID: "ws", Type: "WORKSPACE", Labels: []segment.Label{{Key: "team", Value: "data"}, {Key: "env", Value: "prod"}}},
+			},
+		},
+	}
+
+	sortPermissionsLike(returned, configured)
+
+	got := flattenPermissions(returned)
+	want := []interface{}{
+		flatPermission("role", flatResource("ws", "WORKSPACE", "env", "prod", "team", "data"), flatResource("src", "SOURCE")),
+		flatPermission("role", flatResource("ws", "WORKSPACE", "env", "dev", "team", "data")),
+		flatPermission("role", flatResource("ws", "WORKSPACE", "env", "staging")),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func flatPermission(roleID string, resources ...interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"role_id":  roleID,
+		"resource": resources,
+	}
+}
+
+func flatResource(id string, resourceType string, labels ...string) map[string]interface{} {
+	flatLabels := make([]interface{}, 0, len(labels)/2)
+	for i := 0; i < len(labels); i += 2 {
+		flatLabels = append(flatLabels, map[string]interface{}{
+			"key":   labels[i],
+			"value": labels[i+1],
+		})
+	}
+	return map[string]interface{}{
+		"id":    id,
+		"type":  resourceType,
+		"label": flatLabels,
+	}
+}
@@ -0,0 +1,175 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceProfilesSync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceProfilesSyncCreate,
+		ReadContext:   resourceProfilesSyncRead,
+		UpdateContext: resourceProfilesSyncUpdate,
+		DeleteContext: resourceProfilesSyncDelete,
+
+		Schema: map[string]*schema.Schema{
+			"space_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identifier of the space whose profiles are synced",
+			},
+			"warehouse_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identifier of the warehouse profiles are synced to",
+			},
+			"schema_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Schema in the warehouse the profile tables are written to, Segment picks one from the space when not set",
+			},
+			"enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Flag for whether or not profiles are synced",
+			},
+			"allow_irreversible_changes": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Allow the sync to be replaced, the new sync starts again from a full backfill",
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceProfilesSyncImport,
+		},
+
+		CustomizeDiff: customizeProfilesSyncDiff,
+	}
+}
+
+func resourceProfilesSyncImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set("allow_irreversible_changes", false); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// customizeProfilesSyncDiff refuses to replace an existing sync unless it's
+// been explicitly allowed, as the plan only shows it as forcing replacement.
+func customizeProfilesSyncDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || d.Get("allow_irreversible_changes").(bool) {
+		return nil
+	}
+
+	for _, key := range []string{"space_id", "warehouse_id", "schema_name"} {
+		if !d.HasChange(key) {
+			continue
+		}
+		o, n := d.GetChange(key)
+		return fmt.Errorf("changing %s from %q to %q replaces the Profiles Sync and backfills every profile again, set allow_irreversible_changes to apply it", key, o, n)
+	}
+
+	return nil
+}
+
+func resourceProfilesSyncCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	spaceID := d.Get("space_id").(string)
+
+	profilesWarehouse, err := c.CreateProfilesWarehouse(spaceID, segment.ProfilesWarehouseRequest{
+		WarehouseID: d.Get("warehouse_id").(string),
+		Enabled:     d.Get("enabled").(bool),
+		SchemaName:  d.Get("schema_name").(string),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(compositeID(spaceID, *profilesWarehouse.ID))
+
+	resourceProfilesSyncRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceProfilesSyncRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	spaceID, profilesWarehouseID, err := splitCompositeID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	profilesWarehouse, err := c.GetProfilesWarehouse(spaceID, profilesWarehouseID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("space_id", spaceID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("warehouse_id", profilesWarehouse.WarehouseID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("schema_name", profilesWarehouse.SchemaName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("enabled", profilesWarehouse.Enabled); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceProfilesSyncUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	spaceID, profilesWarehouseID, err := splitCompositeID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("enabled") {
+		_, err := c.UpdateProfilesWarehouse(spaceID, profilesWarehouseID, segment.ProfilesWarehouseRequest{
+			Enabled: d.Get("enabled").(bool),
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceProfilesSyncRead(ctx, d, m)
+}
+
+func resourceProfilesSyncDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	spaceID, profilesWarehouseID, err := splitCompositeID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = c.DeleteProfilesWarehouse(spaceID, profilesWarehouseID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
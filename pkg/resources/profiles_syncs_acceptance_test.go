@@ -0,0 +1,130 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSegmentProfilesSyncResource(t *testing.T) {
+
+	name := strings.ToLower(acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))
+	spaceID := os.Getenv("SEGMENT_SPACE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckSpace(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSegmentProfilesSyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSegmentProfilesSyncResourceBasicConfig(spaceID, name, name, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentProfilesSyncExists("segment_profiles_sync.test_profiles_sync"),
+					resource.TestCheckResourceAttr("segment_profiles_sync.test_profiles_sync", "schema_name", name),
+					resource.TestCheckResourceAttr("segment_profiles_sync.test_profiles_sync", "enabled", "true"),
+				),
+			},
+			// DISABLE
+			{
+				Config: testAccSegmentProfilesSyncResourceBasicConfig(spaceID, name, name, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentProfilesSyncExists("segment_profiles_sync.test_profiles_sync"),
+					resource.TestCheckResourceAttr("segment_profiles_sync.test_profiles_sync", "enabled", "false"),
+				),
+			},
+			// REPLACE WITHOUT ALLOWING IT
+			{
+				Config:      testAccSegmentProfilesSyncResourceBasicConfig(spaceID, name, name+"_new", false, false),
+				ExpectError: regexp.MustCompile("allow_irreversible_changes"),
+			},
+			// REPLACE
+			{
+				Config: testAccSegmentProfilesSyncResourceBasicConfig(spaceID, name, name+"_new", false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentProfilesSyncExists("segment_profiles_sync.test_profiles_sync"),
+					resource.TestCheckResourceAttr("segment_profiles_sync.test_profiles_sync", "schema_name", name+"_new"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "segment_profiles_sync.test_profiles_sync",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"allow_irreversible_changes"},
+			},
+		},
+	})
+}
+
+func testAccSegmentProfilesSyncResourceBasicConfig(spaceID, name, schemaName string, enabled, allow bool) string {
+	return fmt.Sprintf(`
+resource "segment_warehouse" "test_warehouse" {
+  name           = "%s"
+  warehouse_slug = "snowflake"
+  enabled        = false
+  settings {
+    username = "Moo"
+    password = "Password"
+    port     = 22
+    hostname = "www.snowflake.com"
+  }
+}
+
+resource "segment_profiles_sync" "test_profiles_sync" {
+  space_id                   = "%s"
+  warehouse_id               = segment_warehouse.test_warehouse.id
+  schema_name                = "%s"
+  enabled                    = %t
+  allow_irreversible_changes = %t
+}
+`, name, spaceID, schemaName, enabled, allow)
+}
+
+func testAccCheckSegmentProfilesSyncExists(resource string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		apiClient := testAccProvider.Meta().(*segment.Client)
+
+		_, err := apiClient.GetProfilesWarehouse(rs.Primary.Attributes["space_id"], strings.TrimPrefix(rs.Primary.ID, rs.Primary.Attributes["space_id"]+":"))
+		if err != nil {
+			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckSegmentProfilesSyncDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*segment.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "segment_profiles_sync" {
+			continue
+		}
+		spaceID := rs.Primary.Attributes["space_id"]
+
+		_, err := apiClient.GetProfilesWarehouse(spaceID, strings.TrimPrefix(rs.Primary.ID, spaceID+":"))
+		if err == nil {
+			return fmt.Errorf("Profiles sync still exists")
+		}
+		notFoundErr := "not found"
+		expectedErr := regexp.MustCompile(notFoundErr)
+		if !expectedErr.Match([]byte(err.Error())) {
+			return fmt.Errorf("expected %s, got %s", notFoundErr, err)
+		}
+	}
+
+	return nil
+}
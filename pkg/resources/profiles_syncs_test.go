@@ -0,0 +1,55 @@
+package resources
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestProfilesSyncReplacementGuard(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "spa_1:pw_1",
+		Attributes: map[string]string{
+			"id":                         "spa_1:pw_1",
+			"space_id":                   "spa_1",
+			"warehouse_id":               "wh_1",
+			"schema_name":                "profiles",
+			"enabled":                    "true",
+			"allow_irreversible_changes": "false",
+		},
+	}
+
+	cases := []struct {
+		changes map[string]interface{}
+		want    string
+	}{
+		{map[string]interface{}{"enabled": false}, ""},
+		{map[string]interface{}{"space_id": "spa_2"}, `changing space_id from "spa_1" to "spa_2"`},
+		{map[string]interface{}{"warehouse_id": "wh_2"}, `changing warehouse_id from "wh_1" to "wh_2"`},
+		{map[string]interface{}{"schema_name": "other"}, `changing schema_name from "profiles" to "other"`},
+		{map[string]interface{}{"space_id": "spa_2", "allow_irreversible_changes": true}, ""},
+	}
+
+	for _, c := range cases {
+		config := map[string]interface{}{
+			"space_id":     "spa_1",
+			"warehouse_id": "wh_1",
+			"schema_name":  "profiles",
+			"enabled":      true,
+		}
+		for k, v := range c.changes {
+			config[k] = v
+		}
+
+		_, err := ResourceProfilesSync().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+		got := ""
+		if err != nil {
+			got = err.Error()
+		}
+		if (c.want == "") != (got == "") || !strings.Contains(got, c.want) {
+			t.Errorf("changing %v: got error %q, want %q", c.changes, got, c.want)
+		}
+	}
+}
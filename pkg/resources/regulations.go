@@ -0,0 +1,210 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceRegulation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRegulationCreate,
+		ReadContext:   resourceRegulationRead,
+		UpdateContext: resourceRegulationUpdate,
+		DeleteContext: resourceRegulationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"regulation_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Type of the regulation, from a list",
+				ValidateFunc: validation.StringInSlice(segment.RegulationTypes, false),
+			},
+			"subject_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Type of the subject IDs, from a list",
+				ValidateFunc: validation.StringInSlice(segment.RegulationSubjectTypes, false),
+			},
+			"subject_ids": &schema.Schema{
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Description: "Identifiers of the data subjects the regulation applies to",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+			"source_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "",
+				Description: "Identifier of the source to limit the regulation to, applies to the whole workspace when not set",
+			},
+			"wait_for_completion": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Wait for the regulation to finish when creating it, instead of only until Segment has accepted it. Deletions can take days, if the regulation is still pending when the create timeout runs out or it ends in a failed status, only a warning is shown so it isn't submitted again",
+			},
+			"status": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Overall status of the regulation",
+			},
+			"created_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time the regulation was submitted",
+			},
+			"finished_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time the regulation finished, empty while it's pending",
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+	}
+}
+
+func resourceRegulationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	regulationID, err := c.CreateRegulation(d.Get("source_id").(string), segment.RegulationRequest{
+		RegulationType: d.Get("regulation_type").(string),
+		SubjectType:    d.Get("subject_type").(string),
+		SubjectIDs:     expandStringList(d.Get("subject_ids").([]interface{})),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(regulationID)
+
+	pending := []string{}
+	if d.Get("wait_for_completion").(bool) {
+		pending = segment.RegulationPendingStatuses
+	}
+	// The regulation has been submitted, so anything going wrong while waiting
+	// is only a warning, failing would taint it and submit a second one
+	diags := waitForRegulation(ctx, c, regulationID, pending, d.Timeout(schema.TimeoutCreate))
+
+	return append(diags, resourceRegulationRead(ctx, d, m)...)
+}
+
+func resourceRegulationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	regulation, err := c.GetRegulation(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("status", regulation.OverallStatus); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("created_at", regulation.CreatedAt); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("finished_at", regulation.FinishedAt); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// resourceRegulationUpdate only has wait_for_completion to update, which is
+// only used when creating the regulation.
+func resourceRegulationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceRegulationRead(ctx, d, m)
+}
+
+func resourceRegulationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Regulations are kept by Segment as a record of the request, so they're
+	// only removed from state
+	d.SetId("")
+
+	return diags
+}
+
+// waitForRegulation polls the regulation until its status is no longer one
+// of pending, warning if it doesn't get there or ends in a status that didn't
+// apply it.
+func waitForRegulation(ctx context.Context, c *segment.Client, regulationID string, pending []string, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  regulationWaitTargets(pending),
+		Refresh: func() (interface{}, string, error) {
+			regulation, err := c.GetRegulation(regulationID)
+			if err != nil {
+				return nil, "", err
+			}
+			return regulation, regulation.OverallStatus, nil
+		},
+		Timeout:    timeout,
+		Delay:      2 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	r, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Regulation submitted but not finished",
+			Detail:   fmt.Sprintf("Regulation %s was submitted but waiting for it failed, its status will be refreshed on the next plan: %s", regulationID, err),
+		})
+	}
+
+	if status := r.(*segment.Regulation).OverallStatus; regulationFailed(status) {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Regulation failed",
+			Detail:   fmt.Sprintf("Regulation %s ended with status %s, it is kept in state as a record of the request", regulationID, status),
+		})
+	}
+	return diags
+}
+
+// regulationWaitTargets lists the statuses that end the wait, every status
+// that isn't pending.
+func regulationWaitTargets(pending []string) []string {
+	var target []string
+	for _, status := range segment.RegulationStatuses {
+		isPending := false
+		for _, p := range pending {
+			isPending = isPending || p == status
+		}
+		if !isPending {
+			target = append(target, status)
+		}
+	}
+	return target
+}
+
+// regulationFailed tells whether the regulation ended without being applied.
+func regulationFailed(status string) bool {
+	switch status {
+	case "FAILED", "INVALID", "NOT_SUPPORTED":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,47 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSegmentRegulationResource(t *testing.T) {
+
+	subjectID := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSegmentRegulationResourceBasicConfig(subjectID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("segment_regulation.test_regulation", "id"),
+					resource.TestCheckResourceAttrSet("segment_regulation.test_regulation", "status"),
+					resource.TestCheckResourceAttrSet("segment_regulation.test_regulation", "created_at"),
+					resource.TestCheckResourceAttrSet("data.segment_regulations.suppressions", "regulations.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSegmentRegulationResourceBasicConfig(subjectID string) string {
+	return fmt.Sprintf(`
+resource "segment_regulation" "test_regulation" {
+  regulation_type = "SUPPRESS_ONLY"
+  subject_type    = "USER_ID"
+  subject_ids     = ["%s"]
+}
+
+data "segment_regulations" "suppressions" {
+  regulation_types = ["SUPPRESS_ONLY"]
+
+  depends_on = [segment_regulation.test_regulation]
+}
+`, subjectID)
+}
@@ -0,0 +1,36 @@
+package resources
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+)
+
+func TestRegulationWaitTargets(t *testing.T) {
+	if got := regulationWaitTargets(nil); !reflect.DeepEqual(got, segment.RegulationStatuses) {
+		t.Errorf("got targets %v without waiting, want every status %v", got, segment.RegulationStatuses)
+	}
+
+	got := regulationWaitTargets(segment.RegulationPendingStatuses)
+	want := []string{"FAILED", "FINISHED", "INVALID", "NOT_SUPPORTED", "PARTIAL_SUCCESS"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got targets %v when waiting for completion, want %v", got, want)
+	}
+}
+
+func TestRegulationFailed(t *testing.T) {
+	for status, want := range map[string]bool{
+		"FAILED":          true,
+		"INVALID":         true,
+		"NOT_SUPPORTED":   true,
+		"FINISHED":        false,
+		"PARTIAL_SUCCESS": false,
+		"INITIALIZED":     false,
+		"RUNNING":         false,
+	} {
+		if got := regulationFailed(status); got != want {
+			t.Errorf("regulationFailed(%q) = %t, want %t", status, got, want)
+		}
+	}
+}
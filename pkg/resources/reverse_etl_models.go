@@ -0,0 +1,261 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceReverseETLModel() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceReverseETLModelCreate,
+		ReadContext:   resourceReverseETLModelRead,
+		UpdateContext: resourceReverseETLModelUpdate,
+		DeleteContext: resourceReverseETLModelDelete,
+
+		Schema: map[string]*schema.Schema{
+			"source_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identifier of the warehouse source the model queries",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Descriptive name for the model",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Description of the model",
+			},
+			"enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Flag for whether or not the model is enabled",
+			},
+			"query": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "SQL query run against the warehouse to build the model",
+			},
+			"query_identifier_column": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Column of the query that uniquely identifies each record",
+			},
+			"schedule": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "When the query is run, syncs are manual when omitted",
+				Elem: &schema.Resource{
+					Schema: reverseETLScheduleSchema(),
+				},
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func reverseETLScheduleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"strategy": {
+			Description:  "Type of schedule, either PERIODIC or SPECIFIC_DAYS. Omit the schedule for manual syncs",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{"PERIODIC", "SPECIFIC_DAYS"}, false),
+		},
+		"interval": {
+			Description: "How often to run for PERIODIC schedules, i.e. 15m or 1h",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+		},
+		"days": {
+			Description: "Days of the week to run on for SPECIFIC_DAYS schedules, 0 is Sunday",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntBetween(0, 6),
+			},
+		},
+		"hours": {
+			Description: "Hours of the day to run at for SPECIFIC_DAYS schedules",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntBetween(0, 23),
+			},
+		},
+		"timezone": {
+			Description: "Time zone of the hours for SPECIFIC_DAYS schedules, i.e. Europe/London",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+		},
+	}
+}
+
+func resourceReverseETLModelCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	model, err := c.CreateReverseETLModel(expandReverseETLModel(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s", *model.ID))
+
+	resourceReverseETLModelRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceReverseETLModelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	modelID := d.Id()
+
+	model, err := c.GetReverseETLModel(modelID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("source_id", model.SourceID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", model.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("description", model.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("enabled", model.Enabled); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("query", model.Query); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("query_identifier_column", model.QueryIdentifierColumn); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("schedule", flattenReverseETLSchedule(model.ScheduleStrategy, model.ScheduleConfig)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceReverseETLModelUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	modelID := d.Id()
+
+	if d.HasChanges("name", "description", "enabled", "query", "query_identifier_column", "schedule") {
+		_, err := c.UpdateReverseETLModel(modelID, expandReverseETLModel(d))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceReverseETLModelRead(ctx, d, m)
+}
+
+func resourceReverseETLModelDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	modelID := d.Id()
+
+	_, err := c.DeleteReverseETLModel(modelID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func expandReverseETLModel(d *schema.ResourceData) segment.ReverseETLModel {
+	strategy, config := expandReverseETLSchedule(d.Get("schedule").([]interface{}))
+
+	return segment.ReverseETLModel{
+		SourceID:              d.Get("source_id").(string),
+		Name:                  d.Get("name").(string),
+		Description:           d.Get("description").(string),
+		Enabled:               d.Get("enabled").(bool),
+		Query:                 d.Get("query").(string),
+		QueryIdentifierColumn: d.Get("query_identifier_column").(string),
+		ScheduleStrategy:      strategy,
+		ScheduleConfig:        config,
+	}
+}
+
+func expandReverseETLSchedule(schedules []interface{}) (string, map[string]interface{}) {
+	if len(schedules) == 0 || schedules[0] == nil {
+		return "MANUAL", nil
+	}
+	schedule := schedules[0].(map[string]interface{})
+
+	strategy := schedule["strategy"].(string)
+	config := map[string]interface{}{}
+	switch strategy {
+	case "PERIODIC":
+		config["interval"] = schedule["interval"].(string)
+	case "SPECIFIC_DAYS":
+		config["days"] = schedule["days"].([]interface{})
+		config["hours"] = schedule["hours"].([]interface{})
+		config["timezone"] = schedule["timezone"].(string)
+	default:
+		return strategy, nil
+	}
+	return strategy, config
+}
+
+func flattenReverseETLSchedule(strategy string, config map[string]interface{}) []interface{} {
+	if strategy == "" || (strategy == "MANUAL" && len(config) == 0) {
+		return []interface{}{}
+	}
+
+	schedule := map[string]interface{}{
+		"strategy": strategy,
+		"interval": "",
+		"days":     []interface{}{},
+		"hours":    []interface{}{},
+		"timezone": "",
+	}
+	if interval, ok := config["interval"].(string); ok {
+		schedule["interval"] = interval
+	}
+	if timezone, ok := config["timezone"].(string); ok {
+		schedule["timezone"] = timezone
+	}
+	// Numbers are decoded from JSON as float64
+	for _, key := range []string{"days", "hours"} {
+		values, _ := config[key].([]interface{})
+		ints := make([]interface{}, 0, len(values))
+		for _, v := range values {
+			if n, ok := v.(float64); ok {
+				ints = append(ints, int(n))
+			}
+		}
+		schedule[key] = ints
+	}
+	return []interface{}{schedule}
+}
@@ -0,0 +1,137 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSegmentReverseETLModelResource(t *testing.T) {
+
+	name := strings.ToLower(acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))
+	sourceID := os.Getenv("SEGMENT_RETL_SOURCE_ID")
+	destinationID := os.Getenv("SEGMENT_RETL_DESTINATION_ID")
+	actionID := os.Getenv("SEGMENT_RETL_ACTION_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckReverseETL(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSegmentReverseETLModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSegmentReverseETLModelResourceBasicConfig(name, sourceID, destinationID, actionID, "15m"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentReverseETLModelExists("segment_reverse_etl_model.test_model"),
+					resource.TestCheckResourceAttr("segment_reverse_etl_model.test_model", "name", name),
+					resource.TestCheckResourceAttr("segment_reverse_etl_model.test_model", "query_identifier_column", "id"),
+					resource.TestCheckResourceAttr("segment_destination_subscription.test_subscription", "reverse_etl_schedule.0.interval", "15m"),
+				),
+			},
+			// CHANGE SCHEDULE
+			{
+				Config: testAccSegmentReverseETLModelResourceBasicConfig(name, sourceID, destinationID, actionID, "1h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("segment_destination_subscription.test_subscription", "reverse_etl_schedule.0.interval", "1h"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "segment_reverse_etl_model.test_model",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+			},
+			{
+				ResourceName:            "segment_destination_subscription.test_subscription",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"settings"},
+			},
+		},
+	})
+}
+
+func testAccPreCheckReverseETL(t *testing.T) {
+	for _, v := range []string{"SEGMENT_RETL_SOURCE_ID", "SEGMENT_RETL_DESTINATION_ID", "SEGMENT_RETL_ACTION_ID"} {
+		if os.Getenv(v) == "" {
+			t.Fatalf("%s must be set for acceptance tests of Reverse ETL", v)
+		}
+	}
+}
+
+func testAccSegmentReverseETLModelResourceBasicConfig(name, sourceID, destinationID, actionID, interval string) string {
+	return fmt.Sprintf(`
+resource "segment_reverse_etl_model" "test_model" {
+  name                    = "%s"
+  source_id               = "%s"
+  description             = "Managed by Terraform"
+  query                   = "select id, email from users"
+  query_identifier_column = "id"
+}
+
+resource "segment_destination_subscription" "test_subscription" {
+  destination_id = "%s"
+  action_id      = "%s"
+  name           = "%s"
+  trigger        = "type = \"new\""
+  model_id       = segment_reverse_etl_model.test_model.id
+  settings = jsonencode({
+    email = "$${properties.email}"
+  })
+
+  reverse_etl_schedule {
+    strategy = "PERIODIC"
+    interval = "%s"
+  }
+}
+`, name, sourceID, destinationID, actionID, name, interval)
+}
+
+func testAccCheckSegmentReverseETLModelExists(resource string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		apiClient := testAccProvider.Meta().(*segment.Client)
+
+		_, err := apiClient.GetReverseETLModel(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckSegmentReverseETLModelDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*segment.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "segment_reverse_etl_model" {
+			continue
+		}
+		modelID := rs.Primary.ID
+
+		_, err := apiClient.GetReverseETLModel(modelID)
+		if err == nil {
+			return fmt.Errorf("Reverse ETL model still exists")
+		}
+		notFoundErr := "not found"
+		expectedErr := regexp.MustCompile(notFoundErr)
+		if !expectedErr.Match([]byte(err.Error())) {
+			return fmt.Errorf("expected %s, got %s", notFoundErr, err)
+		}
+	}
+
+	return nil
+}
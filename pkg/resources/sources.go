@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -44,126 +45,157 @@ func ResourceSource() *schema.Resource {
 			},
 			"source_slug": &schema.Schema{
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				Description:  "Slug for the source, from a list",
 				ValidateFunc: validation.StringInSlice(segment.SourceSlugs, false),
+				ExactlyOneOf: []string{"source_slug", "metadata_id"},
+			},
+			"metadata_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "Identifier of the source in the catalog, i.e. the `catalog_id` of a `segment_function`",
+				ExactlyOneOf: []string{"source_slug", "metadata_id"},
 			},
 			"settings": &schema.Schema{
 				Type:        schema.TypeList,
 				Required:    true,
 				Description: "Map containing settings for the source",
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"forwarding_violations_to": {
-							Description: "SourceId to forward violations to.",
-							Type:        schema.TypeString,
-							Optional:    true,
-						},
-						"forwarding_blocked_events_to": {
-							Description: "SourceId to forward blocked events to.",
-							Type:        schema.TypeString,
-							Optional:    true,
-						},
-						"track": {
-							Type:     schema.TypeList,
-							Optional: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"allow_unplanned_events": {
-										Description: "Enable to allow unplanned track events.",
-										Type:        schema.TypeBool,
-										Optional:    true,
-										Default:     false,
-									},
-									"allow_unplanned_event_properties": {
-										Description: "Enable to allow unplanned track event properties.",
-										Type:        schema.TypeBool,
-										Optional:    true,
-										Default:     false,
-									},
-									"allow_event_on_violations": {
-										Description: "Allow track event on violations.",
-										Type:        schema.TypeBool,
-										Optional:    true,
-										Default:     false,
-									},
-									"allow_properties_on_violations": {
-										Description: "Enable to allow track properties on violations.",
-										Type:        schema.TypeBool,
-										Optional:    true,
-										Default:     false,
-									},
-									"common_event_on_violations": {
-										Description:  "The common track event on violations.",
-										Type:         schema.TypeString,
-										Optional:     true,
-										Default:      "",
-										ValidateFunc: validation.StringInSlice(ViolationEvents, false),
-									},
-								},
-							},
-						},
-						"identify": {
-							Type:     schema.TypeList,
-							Required: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"allow_unplanned_traits": {
-										Description: "Enable to allow unplanned identify traits.",
-										Type:        schema.TypeBool,
-										Optional:    true,
-										Default:     false,
-									},
-									"allow_traits_on_violations": {
-										Description: "Enable to allow identify traits on violations.",
-										Type:        schema.TypeBool,
-										Optional:    true,
-										Default:     false,
-									},
-									"common_event_on_violations": {
-										Description:  "The common track event on violations.",
-										Type:         schema.TypeString,
-										Optional:     true,
-										Default:      "",
-										ValidateFunc: validation.StringInSlice(ViolationEvents, false),
-									},
-								},
-							},
-						},
-						"group": {
-							Type:     schema.TypeList,
-							Required: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"allow_unplanned_traits": {
-										Description: "Enable to allow unplanned identify traits.",
-										Type:        schema.TypeBool,
-										Optional:    true,
-										Default:     false,
-									},
-									"allow_traits_on_violations": {
-										Description: "Enable to allow identify traits on violations.",
-										Type:        schema.TypeBool,
-										Optional:    true,
-										Default:     false,
-									},
-									"common_event_on_violations": {
-										Description:  "The common track event on violations.",
-										Type:         schema.TypeString,
-										Default:      "",
-										Optional:     true,
-										ValidateFunc: validation.StringInSlice(ViolationEvents, false),
-									},
-								},
-							},
-						},
-					},
+					Schema: SourceSettingsSchema(),
 				},
 			},
 		},
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceSourceImport,
+		},
+	}
+}
+
+// resourceSourceImport accepts the ID of the source, or its slug as
+// slug:<source-slug>.
+func resourceSourceImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*segment.Client)
+
+	if slug := strings.TrimPrefix(d.Id(), "slug:"); slug != d.Id() {
+		source, err := c.GetSourceBySlug(slug)
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(*source.ID)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// SourceSettingsSchema is the schema of the settings block of a source, also
+// used by the data sources that read sources.
+func SourceSettingsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"forwarding_violations_to": {
+			Description: "SourceId to forward violations to.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"forwarding_blocked_events_to": {
+			Description: "SourceId to forward blocked events to.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"track": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"allow_unplanned_events": {
+						Description: "Enable to allow unplanned track events.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+					"allow_unplanned_event_properties": {
+						Description: "Enable to allow unplanned track event properties.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+					"allow_event_on_violations": {
+						Description: "Allow track event on violations.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+					"allow_properties_on_violations": {
+						Description: "Enable to allow track properties on violations.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+					"common_event_on_violations": {
+						Description:  "The common track event on violations.",
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "",
+						ValidateFunc: validation.StringInSlice(ViolationEvents, false),
+					},
+				},
+			},
+		},
+		"identify": {
+			Type:     schema.TypeList,
+			Required: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"allow_unplanned_traits": {
+						Description: "Enable to allow unplanned identify traits.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+					"allow_traits_on_violations": {
+						Description: "Enable to allow identify traits on violations.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+					"common_event_on_violations": {
+						Description:  "The common track event on violations.",
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "",
+						ValidateFunc: validation.StringInSlice(ViolationEvents, false),
+					},
+				},
+			},
+		},
+		"group": {
+			Type:     schema.TypeList,
+			Required: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"allow_unplanned_traits": {
+						Description: "Enable to allow unplanned identify traits.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+					"allow_traits_on_violations": {
+						Description: "Enable to allow identify traits on violations.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+					"common_event_on_violations": {
+						Description:  "The common track event on violations.",
+						Type:         schema.TypeString,
+						Default:      "",
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(ViolationEvents, false),
+					},
+				},
+			},
 		},
 	}
 }
@@ -178,9 +210,16 @@ func resourceSourceCreate(ctx context.Context, d *schema.ResourceData, m interfa
 	name := d.Get("name").(string)
 	sourceSlug := d.Get("source_slug").(string)
 	settings := d.Get("settings").([]interface{})
+	metadataID := d.Get("metadata_id").(string)
 	sourceSettings := mapToSourceSettings(settings)
 
-	source, err := c.CreateSource(slug, enabled, name, sourceSlug, sourceSettings)
+	var source *segment.Source
+	var err error
+	if metadataID != "" {
+		source, err = c.CreateSourceFromMetadata(slug, enabled, name, metadataID, sourceSettings)
+	} else {
+		source, err = c.CreateSource(slug, enabled, name, sourceSlug, sourceSettings)
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -235,10 +274,11 @@ func mapToSourceSettings(settings []interface{}) segment.SourceSettings {
 			CommonEventOnViolations: groupSettings["common_event_on_violations"].(string),
 		},
 	}
-	return segment.SourceSettings{}
 }
 
-func flattenSourceSettings(sourceSettings segment.SourceSettings) []interface{} {
+// FlattenSourceSettings converts settings to the shape of
+// SourceSettingsSchema.
+func FlattenSourceSettings(sourceSettings segment.SourceSettings) []interface{} {
 	var settings map[string]interface{}
 	settingsJson, _ := json.Marshal(sourceSettings)
 	json.Unmarshal(settingsJson, &settings)
@@ -285,8 +325,11 @@ func resourceSourceRead(ctx context.Context, d *schema.ResourceData, m interface
 	if err := d.Set("source_slug", source.Metadata.Slug); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set("metadata_id", source.Metadata.ID); err != nil {
+		return diag.FromErr(err)
+	}
 
-	s := flattenSourceSettings(source.Settings)
+	s := FlattenSourceSettings(source.Settings)
 	if err := d.Set("settings", s); err != nil {
 		return diag.FromErr(err)
 	}
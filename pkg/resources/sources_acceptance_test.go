@@ -59,6 +59,13 @@ func TestAccSegmentSourceResource(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{},
 			},
+			// IMPORT BY SLUG
+			{
+				ResourceName:      "segment_source.test_source",
+				ImportState:       true,
+				ImportStateId:     "slug:" + slug,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -0,0 +1,124 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceSpaceSourceConnection() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSpaceSourceConnectionCreate,
+		ReadContext:   resourceSpaceSourceConnectionRead,
+		DeleteContext: resourceSpaceSourceConnectionDelete,
+
+		Schema: map[string]*schema.Schema{
+			"space_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identifier of the space to connect",
+			},
+			"source_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identifier of the source feeding profiles into the space",
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSpaceSourceConnectionImport,
+		},
+	}
+}
+
+func resourceSpaceSourceConnectionImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	spaceID, sourceID, err := splitCompositeID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("space_id", spaceID); err != nil {
+		return nil, err
+	}
+	if err := d.Set("source_id", sourceID); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceSpaceSourceConnectionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	spaceID := d.Get("space_id").(string)
+	sourceID := d.Get("source_id").(string)
+
+	err := c.AddSourceToSpace(spaceID, sourceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(compositeID(spaceID, sourceID))
+
+	resourceSpaceSourceConnectionRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceSpaceSourceConnectionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	spaceID, sourceID, err := splitCompositeID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sources, err := c.ListSpaceSources(spaceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	connected := false
+	for _, source := range sources {
+		if source.ID != nil && *source.ID == sourceID {
+			connected = true
+			break
+		}
+	}
+	if !connected {
+		d.SetId("")
+		return diags
+	}
+
+	if err := d.Set("space_id", spaceID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("source_id", sourceID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceSpaceSourceConnectionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	spaceID, sourceID, err := splitCompositeID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = c.RemoveSourceFromSpace(spaceID, sourceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,135 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSegmentSpaceSourceConnectionResource(t *testing.T) {
+
+	sourceSlug := strings.ToLower(acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))
+	spaceID := os.Getenv("SEGMENT_SPACE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckSpace(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSegmentSpaceSourceConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSegmentSpaceSourceConnectionResourceBasicConfig(spaceID, sourceSlug),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentSpaceSourceConnectionExists("segment_space_source_connection.test_connection"),
+					resource.TestCheckResourceAttr("segment_space_source_connection.test_connection", "space_id", spaceID),
+					resource.TestCheckResourceAttrSet("data.segment_space.test_space", "name"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "segment_space_source_connection.test_connection",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+			},
+		},
+	})
+}
+
+func testAccPreCheckSpace(t *testing.T) {
+	if v := os.Getenv("SEGMENT_SPACE_ID"); v == "" {
+		t.Fatal("SEGMENT_SPACE_ID must be set for acceptance tests of spaces")
+	}
+}
+
+func testAccSegmentSpaceSourceConnectionResourceBasicConfig(spaceID, sourceSlug string) string {
+	return fmt.Sprintf(`
+data "segment_space" "test_space" {
+  space_id = "%s"
+}
+
+resource "segment_source" "test_source" {
+  slug        = "%s"
+  name        = "%s"
+  source_slug = "http-api"
+  enabled     = false
+  settings {
+    track {
+    }
+    identify {
+    }
+    group {
+    }
+  }
+}
+
+resource "segment_space_source_connection" "test_connection" {
+  space_id  = data.segment_space.test_space.space_id
+  source_id = segment_source.test_source.id
+}
+`, spaceID, sourceSlug, sourceSlug)
+}
+
+func testAccCheckSegmentSpaceSourceConnectionExists(resource string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		apiClient := testAccProvider.Meta().(*segment.Client)
+		spaceID := rs.Primary.Attributes["space_id"]
+		sourceID := rs.Primary.Attributes["source_id"]
+
+		connected, err := isSourceConnectedToSpace(apiClient, spaceID, sourceID)
+		if err != nil {
+			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
+		}
+		if !connected {
+			return fmt.Errorf("Source %s is not connected to space %s", sourceID, spaceID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckSegmentSpaceSourceConnectionDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*segment.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "segment_space_source_connection" {
+			continue
+		}
+		spaceID := rs.Primary.Attributes["space_id"]
+		sourceID := rs.Primary.Attributes["source_id"]
+
+		connected, err := isSourceConnectedToSpace(apiClient, spaceID, sourceID)
+		if err != nil {
+			return err
+		}
+		if connected {
+			return fmt.Errorf("Source %s is still connected to space %s", sourceID, spaceID)
+		}
+	}
+
+	return nil
+}
+
+func isSourceConnectedToSpace(apiClient *segment.Client, spaceID, sourceID string) (bool, error) {
+	sources, err := apiClient.ListSpaceSources(spaceID)
+	if err != nil {
+		return false, err
+	}
+	for _, source := range sources {
+		if source.ID != nil && *source.ID == sourceID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,304 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/jsonschema"
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Rules are sent in batches as tracking plans can hold thousands of them.
+const trackingPlanRulesBatchSize = 200
+
+func ResourceTrackingPlanRules() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTrackingPlanRulesCreate,
+		ReadContext:   resourceTrackingPlanRulesRead,
+		UpdateContext: resourceTrackingPlanRulesUpdate,
+		DeleteContext: resourceTrackingPlanRulesDelete,
+
+		Schema: map[string]*schema.Schema{
+			"tracking_plan_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identifier of the tracking plan the rules belong to",
+			},
+			"rule": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Set of rules for the tracking plan, this resource manages every rule in the plan",
+				Set:         hashTrackingPlanRule,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Description:  "Type of the rule, from a list",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(segment.TrackingPlanRuleTypes, false),
+						},
+						"key": {
+							Description: "Key of the rule, i.e. the event name for TRACK rules",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "",
+						},
+						"version": {
+							Description: "Version of the rule",
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     1,
+						},
+						"json_schema": {
+							Description:      "JSON Schema (draft-07) for the rule, as a JSON encoded string",
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateJSONSchema,
+							StateFunc:        normalizeJSONSchema,
+						},
+					},
+				},
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTrackingPlanRulesImport,
+		},
+	}
+}
+
+func resourceTrackingPlanRulesImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set("tracking_plan_id", d.Id()); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceTrackingPlanRulesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	trackingPlanID := d.Get("tracking_plan_id").(string)
+	rules, err := expandTrackingPlanRules(d.Get("rule").(*schema.Set).List())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	batches := batchTrackingPlanRules(rules)
+	if len(batches) == 0 {
+		batches = [][]segment.TrackingPlanRule{{}}
+	}
+
+	// The first batch replaces anything already in the plan, the rest are added to it
+	err = c.ReplaceTrackingPlanRules(trackingPlanID, batches[0])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for _, batch := range batches[1:] {
+		err = c.UpdateTrackingPlanRules(trackingPlanID, batch)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId(trackingPlanID)
+
+	resourceTrackingPlanRulesRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceTrackingPlanRulesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	trackingPlanID := d.Id()
+
+	rules, err := c.ListTrackingPlanRules(trackingPlanID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("tracking_plan_id", trackingPlanID); err != nil {
+		return diag.FromErr(err)
+	}
+	r, err := flattenTrackingPlanRules(rules)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("rule", r); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceTrackingPlanRulesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	trackingPlanID := d.Id()
+
+	if d.HasChange("rule") {
+		o, n := d.GetChange("rule")
+		oldRules := o.(*schema.Set)
+		newRules := n.(*schema.Set)
+
+		// Only the rules whose hash changed are sent, so large plans don't
+		// have to be re-uploaded for a single edited event
+		upserts, err := expandTrackingPlanRules(newRules.Difference(oldRules).List())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		candidates, err := expandTrackingPlanRules(oldRules.Difference(newRules).List())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		upserted := make(map[string]bool, len(upserts))
+		for _, rule := range upserts {
+			upserted[trackingPlanRuleIdentity(rule)] = true
+		}
+		var removals []segment.TrackingPlanRule
+		for _, rule := range candidates {
+			if !upserted[trackingPlanRuleIdentity(rule)] {
+				removals = append(removals, segment.TrackingPlanRule{
+					Type:    rule.Type,
+					Key:     rule.Key,
+					Version: rule.Version,
+				})
+			}
+		}
+
+		for _, batch := range batchTrackingPlanRules(removals) {
+			err = c.RemoveTrackingPlanRules(trackingPlanID, batch)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		for _, batch := range batchTrackingPlanRules(upserts) {
+			err = c.UpdateTrackingPlanRules(trackingPlanID, batch)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceTrackingPlanRulesRead(ctx, d, m)
+}
+
+func resourceTrackingPlanRulesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	trackingPlanID := d.Id()
+
+	err := c.ReplaceTrackingPlanRules(trackingPlanID, []segment.TrackingPlanRule{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func validateJSONSchema(i interface{}, path cty.Path) diag.Diagnostics {
+	diags := validation.ToDiagFunc(validation.StringIsJSON)(i, path)
+	if diags.HasError() {
+		return diags
+	}
+
+	var document interface{}
+	err := json.Unmarshal([]byte(i.(string)), &document)
+	if err == nil {
+		err = jsonschema.ValidateDraft07(document)
+	}
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid JSON Schema",
+			Detail:        err.Error(),
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
+func expandTrackingPlanRules(rules []interface{}) ([]segment.TrackingPlanRule, error) {
+	trackingPlanRules := make([]segment.TrackingPlanRule, 0, len(rules))
+	for _, r := range rules {
+		rule := r.(map[string]interface{})
+
+		var jsonSchema map[string]interface{}
+		if err := json.Unmarshal([]byte(rule["json_schema"].(string)), &jsonSchema); err != nil {
+			return nil, fmt.Errorf("invalid json_schema for %s rule %q: %s", rule["type"], rule["key"], err)
+		}
+
+		trackingPlanRules = append(trackingPlanRules, segment.TrackingPlanRule{
+			Type:       rule["type"].(string),
+			Key:        rule["key"].(string),
+			Version:    rule["version"].(int),
+			JSONSchema: jsonSchema,
+		})
+	}
+	return trackingPlanRules, nil
+}
+
+func flattenTrackingPlanRules(rules []segment.TrackingPlanRule) ([]interface{}, error) {
+	flatRules := make([]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		jsonSchema, err := json.Marshal(rule.JSONSchema)
+		if err != nil {
+			return nil, err
+		}
+		flatRules = append(flatRules, map[string]interface{}{
+			"type":        rule.Type,
+			"key":         rule.Key,
+			"version":     rule.Version,
+			"json_schema": string(jsonSchema),
+		})
+	}
+	return flatRules, nil
+}
+
+// normalizeJSONSchema stores the JSON Schema the way Read flattens it, so
+// whitespace and key ordering in the configuration don't show up as changes.
+func normalizeJSONSchema(v interface{}) string {
+	jsonSchema, err := structure.NormalizeJsonString(v)
+	if err != nil {
+		return v.(string)
+	}
+	return jsonSchema
+}
+
+// hashTrackingPlanRule hashes on the normalised JSON Schema too, the set
+// hashes the configuration before normalizeJSONSchema is applied.
+func hashTrackingPlanRule(v interface{}) int {
+	rule := v.(map[string]interface{})
+	jsonSchema := normalizeJSONSchema(rule["json_schema"])
+	return schema.HashString(fmt.Sprintf("%s/%s/%d/%s", rule["type"], rule["key"], rule["version"], jsonSchema))
+}
+
+func trackingPlanRuleIdentity(rule segment.TrackingPlanRule) string {
+	return fmt.Sprintf("%s/%s/%d", rule.Type, rule.Key, rule.Version)
+}
+
+func batchTrackingPlanRules(rules []segment.TrackingPlanRule) [][]segment.TrackingPlanRule {
+	var batches [][]segment.TrackingPlanRule
+	for start := 0; start < len(rules); start += trackingPlanRulesBatchSize {
+		end := start + trackingPlanRulesBatchSize
+		if end > len(rules) {
+			end = len(rules)
+		}
+		batches = append(batches, rules[start:end])
+	}
+	return batches
+}
@@ -0,0 +1,44 @@
+package resources
+
+import (
+	"testing"
+)
+
+func TestTrackingPlanRuleJSONSchemaRoundTrip(t *testing.T) {
+	configured := map[string]interface{}{
+		"type":    "TRACK",
+		"key":     "Order Completed",
+		"version": 1,
+		"json_schema": `{
+  "type": "object",
+  "properties": {
+    "properties": {
+      "required": ["order_id"],
+      "type": "object"
+    }
+  }
+}
+`,
+	}
+
+	rules, err := expandTrackingPlanRules([]interface{}{configured})
+	if err != nil {
+		t.Fatal(err)
+	}
+	flattened, err := flattenTrackingPlanRules(rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	read := flattened[0].(map[string]interface{})
+
+	want := `{"properties":{"properties":{"required":["order_id"],"type":"object"}},"type":"object"}`
+	if read["json_schema"] != want {
+		t.Errorf("got flattened json_schema %s, want %s", read["json_schema"], want)
+	}
+	if got := normalizeJSONSchema(configured["json_schema"]); got != read["json_schema"] {
+		t.Errorf("got stored json_schema %s, want the flattened %s", got, read["json_schema"])
+	}
+	if hashTrackingPlanRule(configured) != hashTrackingPlanRule(read) {
+		t.Error("expected the configured and read rules to hash the same")
+	}
+}
@@ -0,0 +1,180 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceTrackingPlanSourceConnection() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTrackingPlanSourceConnectionCreate,
+		ReadContext:   resourceTrackingPlanSourceConnectionRead,
+		UpdateContext: resourceTrackingPlanSourceConnectionUpdate,
+		DeleteContext: resourceTrackingPlanSourceConnectionDelete,
+
+		Schema: map[string]*schema.Schema{
+			"tracking_plan_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identifier of the tracking plan to connect",
+			},
+			"source_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identifier of the source to connect the tracking plan to",
+			},
+			"settings": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Violation handling settings applied to the source once the tracking plan is connected, if set then changes to `settings` on the `segment_source` should be ignored",
+				Elem: &schema.Resource{
+					Schema: SourceSettingsSchema(),
+				},
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTrackingPlanSourceConnectionImport,
+		},
+	}
+}
+
+func resourceTrackingPlanSourceConnectionImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	trackingPlanID, sourceID, err := splitCompositeID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("tracking_plan_id", trackingPlanID); err != nil {
+		return nil, err
+	}
+	if err := d.Set("source_id", sourceID); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceTrackingPlanSourceConnectionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	trackingPlanID := d.Get("tracking_plan_id").(string)
+	sourceID := d.Get("source_id").(string)
+
+	err := c.AddSourceToTrackingPlan(trackingPlanID, sourceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(compositeID(trackingPlanID, sourceID))
+
+	// Violation handling only takes effect once the plan is connected, so
+	// the settings are applied after connecting
+	settings := d.Get("settings").([]interface{})
+	if len(settings) > 0 {
+		err = updateSourceSettings(c, sourceID, settings)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	resourceTrackingPlanSourceConnectionRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceTrackingPlanSourceConnectionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	trackingPlanID, sourceID, err := splitCompositeID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sources, err := c.ListTrackingPlanSources(trackingPlanID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	connected := false
+	for _, source := range sources {
+		if source.ID != nil && *source.ID == sourceID {
+			connected = true
+			break
+		}
+	}
+	if !connected {
+		d.SetId("")
+		return diags
+	}
+
+	source, err := c.GetSource(sourceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("tracking_plan_id", trackingPlanID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("source_id", sourceID); err != nil {
+		return diag.FromErr(err)
+	}
+	s := FlattenSourceSettings(source.Settings)
+	if err := d.Set("settings", s); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceTrackingPlanSourceConnectionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	if d.HasChange("settings") {
+		settings := d.Get("settings").([]interface{})
+		if len(settings) > 0 {
+			err := updateSourceSettings(c, d.Get("source_id").(string), settings)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceTrackingPlanSourceConnectionRead(ctx, d, m)
+}
+
+func resourceTrackingPlanSourceConnectionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	trackingPlanID, sourceID, err := splitCompositeID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = c.RemoveSourceFromTrackingPlan(trackingPlanID, sourceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateSourceSettings(c *segment.Client, sourceID string, settings []interface{}) error {
+	source, err := c.GetSource(sourceID)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.UpdateSource(*source.ID, source.Slug, source.Enabled, source.Name, mapToSourceSettings(settings))
+	return err
+}
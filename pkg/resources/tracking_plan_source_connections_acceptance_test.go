@@ -0,0 +1,150 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSegmentTrackingPlanSourceConnectionResource(t *testing.T) {
+
+	name := strings.ToLower(acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))
+	sourceSlug := strings.ToLower(acctest.RandStringFromCharSet(4, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSegmentTrackingPlanSourceConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSegmentTrackingPlanSourceConnectionResourceBasicConfig(name, sourceSlug, "ALLOW"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentTrackingPlanSourceConnectionExists("segment_tracking_plan_source_connection.test_connection"),
+					resource.TestCheckResourceAttr("segment_tracking_plan_source_connection.test_connection", "settings.0.identify.0.common_event_on_violations", "ALLOW"),
+				),
+			},
+			// CHANGE SETTINGS
+			{
+				Config: testAccSegmentTrackingPlanSourceConnectionResourceBasicConfig(name, sourceSlug, "BLOCK"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentTrackingPlanSourceConnectionExists("segment_tracking_plan_source_connection.test_connection"),
+					resource.TestCheckResourceAttr("segment_tracking_plan_source_connection.test_connection", "settings.0.identify.0.common_event_on_violations", "BLOCK"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "segment_tracking_plan_source_connection.test_connection",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+			},
+		},
+	})
+}
+
+func testAccSegmentTrackingPlanSourceConnectionResourceBasicConfig(name, sourceSlug, commonEventOnViolations string) string {
+	return fmt.Sprintf(`
+resource "segment_tracking_plan" "test_tracking_plan" {
+  name = "%s"
+}
+
+resource "segment_source" "test_source" {
+  slug        = "%s"
+  name        = "%s"
+  source_slug = "http-api"
+  enabled     = false
+  settings {
+    track {
+    }
+    identify {
+    }
+    group {
+    }
+  }
+
+  lifecycle {
+    ignore_changes = [settings]
+  }
+}
+
+resource "segment_tracking_plan_source_connection" "test_connection" {
+  tracking_plan_id = segment_tracking_plan.test_tracking_plan.id
+  source_id        = segment_source.test_source.id
+  settings {
+    track {
+      allow_unplanned_events = true
+    }
+    identify {
+      common_event_on_violations = "%s"
+    }
+    group {
+    }
+  }
+}
+`, name, sourceSlug, name, commonEventOnViolations)
+}
+
+func testAccCheckSegmentTrackingPlanSourceConnectionExists(resource string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		apiClient := testAccProvider.Meta().(*segment.Client)
+		trackingPlanID := rs.Primary.Attributes["tracking_plan_id"]
+		sourceID := rs.Primary.Attributes["source_id"]
+
+		connected, err := isSourceConnectedToTrackingPlan(apiClient, trackingPlanID, sourceID)
+		if err != nil {
+			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
+		}
+		if !connected {
+			return fmt.Errorf("Source %s is not connected to tracking plan %s", sourceID, trackingPlanID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckSegmentTrackingPlanSourceConnectionDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*segment.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "segment_tracking_plan_source_connection" {
+			continue
+		}
+		trackingPlanID := rs.Primary.Attributes["tracking_plan_id"]
+		sourceID := rs.Primary.Attributes["source_id"]
+
+		connected, err := isSourceConnectedToTrackingPlan(apiClient, trackingPlanID, sourceID)
+		if err != nil {
+			// The tracking plan itself has been deleted
+			continue
+		}
+		if connected {
+			return fmt.Errorf("Source %s is still connected to tracking plan %s", sourceID, trackingPlanID)
+		}
+	}
+
+	return nil
+}
+
+func isSourceConnectedToTrackingPlan(apiClient *segment.Client, trackingPlanID, sourceID string) (bool, error) {
+	sources, err := apiClient.ListTrackingPlanSources(trackingPlanID)
+	if err != nil {
+		return false, err
+	}
+	for _, source := range sources {
+		if source.ID != nil && *source.ID == sourceID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,132 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceTrackingPlan() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTrackingPlanCreate,
+		ReadContext:   resourceTrackingPlanRead,
+		UpdateContext: resourceTrackingPlanUpdate,
+		DeleteContext: resourceTrackingPlanDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Descriptive name for the tracking plan",
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "LIVE",
+				Description:  "Type of the tracking plan, from a list",
+				ValidateFunc: validation.StringInSlice(segment.TrackingPlanTypes, false),
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Description of the tracking plan",
+			},
+			"slug": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Slug generated by Segment for the tracking plan",
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceTrackingPlanCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	name := d.Get("name").(string)
+	planType := d.Get("type").(string)
+	description := d.Get("description").(string)
+
+	trackingPlan, err := c.CreateTrackingPlan(name, planType, description)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s", *trackingPlan.ID))
+
+	resourceTrackingPlanRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceTrackingPlanRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	trackingPlanID := d.Id()
+
+	trackingPlan, err := c.GetTrackingPlan(trackingPlanID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("name", trackingPlan.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("type", trackingPlan.Type); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("description", trackingPlan.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("slug", trackingPlan.Slug); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceTrackingPlanUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	trackingPlanID := d.Id()
+
+	if d.HasChange("name") || d.HasChange("description") {
+		name := d.Get("name").(string)
+		description := d.Get("description").(string)
+
+		_, err := c.UpdateTrackingPlan(trackingPlanID, name, description)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceTrackingPlanRead(ctx, d, m)
+}
+
+func resourceTrackingPlanDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	trackingPlanID := d.Id()
+
+	_, err := c.DeleteTrackingPlan(trackingPlanID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,162 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSegmentTrackingPlanResource(t *testing.T) {
+
+	name := strings.ToLower(acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))
+	name2 := strings.ToLower(acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSegmentTrackingPlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSegmentTrackingPlanResourceBasicConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentTrackingPlanExists("segment_tracking_plan.test_tracking_plan"),
+					resource.TestCheckResourceAttr("segment_tracking_plan.test_tracking_plan", "name", name),
+					resource.TestCheckResourceAttr("segment_tracking_plan.test_tracking_plan", "type", "LIVE"),
+					resource.TestCheckResourceAttr("segment_tracking_plan_rules.test_rules", "rule.#", "1"),
+				),
+			},
+			// RENAME AND ADD RULES
+			{
+				Config: testAccSegmentTrackingPlanResourceFullConfig(name2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentTrackingPlanExists("segment_tracking_plan.test_tracking_plan"),
+					resource.TestCheckResourceAttr("segment_tracking_plan.test_tracking_plan", "name", name2),
+					resource.TestCheckResourceAttr("segment_tracking_plan.test_tracking_plan", "description", "Managed by Terraform"),
+					resource.TestCheckResourceAttr("segment_tracking_plan_rules.test_rules", "rule.#", "2"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "segment_tracking_plan.test_tracking_plan",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+			},
+			{
+				ResourceName:            "segment_tracking_plan_rules.test_rules",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+			},
+		},
+	})
+}
+
+func testAccSegmentTrackingPlanResourceBasicConfig(name string) string {
+	return fmt.Sprintf(`
+resource "segment_tracking_plan" "test_tracking_plan" {
+  name = "%s"
+}
+
+resource "segment_tracking_plan_rules" "test_rules" {
+  tracking_plan_id = segment_tracking_plan.test_tracking_plan.id
+  rule {
+    type        = "TRACK"
+    key         = "Order Completed"
+    json_schema = jsonencode({
+      "$schema" = "http://json-schema.org/draft-07/schema#"
+      type      = "object"
+      properties = {
+        properties = {
+          type     = "object"
+          required = ["order_id"]
+        }
+      }
+    })
+  }
+}
+`, name)
+}
+
+func testAccSegmentTrackingPlanResourceFullConfig(name string) string {
+	return fmt.Sprintf(`
+resource "segment_tracking_plan" "test_tracking_plan" {
+  name        = "%s"
+  description = "Managed by Terraform"
+}
+
+resource "segment_tracking_plan_rules" "test_rules" {
+  tracking_plan_id = segment_tracking_plan.test_tracking_plan.id
+  rule {
+    type        = "TRACK"
+    key         = "Order Completed"
+    json_schema = jsonencode({
+      "$schema" = "http://json-schema.org/draft-07/schema#"
+      type      = "object"
+      properties = {
+        properties = {
+          type     = "object"
+          required = ["order_id", "total"]
+        }
+      }
+    })
+  }
+  rule {
+    type        = "IDENTIFY"
+    json_schema = jsonencode({
+      "$schema" = "http://json-schema.org/draft-07/schema#"
+      type      = "object"
+    })
+  }
+}
+`, name)
+}
+
+func testAccCheckSegmentTrackingPlanExists(resource string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		apiClient := testAccProvider.Meta().(*segment.Client)
+		trackingPlanID := rs.Primary.ID
+
+		_, err := apiClient.GetTrackingPlan(trackingPlanID)
+		if err != nil {
+			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckSegmentTrackingPlanDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*segment.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "segment_tracking_plan" {
+			continue
+		}
+		trackingPlanID := rs.Primary.ID
+
+		_, err := apiClient.GetTrackingPlan(trackingPlanID)
+		if err == nil {
+			return fmt.Errorf("Tracking plan still exists")
+		}
+		notFoundErr := "not found"
+		expectedErr := regexp.MustCompile(notFoundErr)
+		if !expectedErr.Match([]byte(err.Error())) {
+			return fmt.Errorf("expected %s, got %s", notFoundErr, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,239 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceTransformation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTransformationCreate,
+		ReadContext:   resourceTransformationRead,
+		UpdateContext: resourceTransformationUpdate,
+		DeleteContext: resourceTransformationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Descriptive name for the transformation",
+			},
+			"source_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Identifier of the source the transformation applies to",
+			},
+			"destination_metadata_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Identifier of the destination in the catalog (`metadata_id` of a `segment_destination`) to limit the transformation to, otherwise it applies to every destination of the source",
+			},
+			"if": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "FQL expression selecting the events to transform, i.e. `event = \"Order Completed\"`",
+				ValidateDiagFunc: validateFQL,
+				DiffSuppressFunc: suppressEquivalentFQL,
+			},
+			"enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Flag for whether or not the transformation is enabled",
+			},
+			"new_event_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "New name for matching events, only valid for track events",
+			},
+			"property_rename": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Properties to rename on matching events",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"old_name": {
+							Description: "Current name of the property",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"new_name": {
+							Description: "New name for the property",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+					},
+				},
+			},
+			"property_value_transformation": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Values to set on properties of matching events",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"property_paths": {
+							Description: "Paths of the properties to change, i.e. `properties.plan`",
+							Type:        schema.TypeList,
+							Required:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"property_value": {
+							Description: "Value to set the properties to",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceTransformationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	transformation, err := c.CreateTransformation(expandTransformation(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s", *transformation.ID))
+
+	resourceTransformationRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceTransformationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	transformationID := d.Id()
+
+	transformation, err := c.GetTransformation(transformationID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("name", transformation.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("source_id", transformation.SourceID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("destination_metadata_id", transformation.DestinationMetadataID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("if", transformation.If); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("enabled", transformation.Enabled); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("new_event_name", transformation.NewEventName); err != nil {
+		return diag.FromErr(err)
+	}
+
+	renames := make([]interface{}, 0, len(transformation.PropertyRenames))
+	for _, rename := range transformation.PropertyRenames {
+		renames = append(renames, map[string]interface{}{
+			"old_name": rename.OldName,
+			"new_name": rename.NewName,
+		})
+	}
+	if err := d.Set("property_rename", renames); err != nil {
+		return diag.FromErr(err)
+	}
+
+	valueTransformations := make([]interface{}, 0, len(transformation.PropertyValueTransformations))
+	for _, valueTransformation := range transformation.PropertyValueTransformations {
+		valueTransformations = append(valueTransformations, map[string]interface{}{
+			"property_paths": valueTransformation.PropertyPaths,
+			"property_value": valueTransformation.PropertyValue,
+		})
+	}
+	if err := d.Set("property_value_transformation", valueTransformations); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceTransformationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	transformationID := d.Id()
+
+	if d.HasChanges("name", "source_id", "destination_metadata_id", "if", "enabled", "new_event_name", "property_rename", "property_value_transformation") {
+		_, err := c.UpdateTransformation(transformationID, expandTransformation(d))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceTransformationRead(ctx, d, m)
+}
+
+func resourceTransformationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	transformationID := d.Id()
+
+	_, err := c.DeleteTransformation(transformationID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func expandTransformation(d *schema.ResourceData) segment.Transformation {
+	var renames []segment.PropertyRename
+	for _, r := range d.Get("property_rename").([]interface{}) {
+		rename := r.(map[string]interface{})
+		renames = append(renames, segment.PropertyRename{
+			OldName: rename["old_name"].(string),
+			NewName: rename["new_name"].(string),
+		})
+	}
+
+	var valueTransformations []segment.PropertyValueTransformation
+	for _, v := range d.Get("property_value_transformation").([]interface{}) {
+		valueTransformation := v.(map[string]interface{})
+		var paths []string
+		for _, path := range valueTransformation["property_paths"].([]interface{}) {
+			paths = append(paths, path.(string))
+		}
+		valueTransformations = append(valueTransformations, segment.PropertyValueTransformation{
+			PropertyPaths: paths,
+			PropertyValue: valueTransformation["property_value"].(string),
+		})
+	}
+
+	return segment.Transformation{
+		Name:                         d.Get("name").(string),
+		SourceID:                     d.Get("source_id").(string),
+		DestinationMetadataID:        d.Get("destination_metadata_id").(string),
+		Enabled:                      d.Get("enabled").(bool),
+		If:                           d.Get("if").(string),
+		NewEventName:                 d.Get("new_event_name").(string),
+		PropertyRenames:              renames,
+		PropertyValueTransformations: valueTransformations,
+	}
+}
@@ -0,0 +1,129 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSegmentTransformationResource(t *testing.T) {
+
+	name := strings.ToLower(acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))
+	sourceSlug := strings.ToLower(acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSegmentTransformationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSegmentTransformationResourceBasicConfig(sourceSlug, name, "event = 'Order Completed'", "Order Placed"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentTransformationExists("segment_transformation.test_transformation"),
+					resource.TestCheckResourceAttr("segment_transformation.test_transformation", "name", name),
+					resource.TestCheckResourceAttr("segment_transformation.test_transformation", "new_event_name", "Order Placed"),
+					resource.TestCheckResourceAttr("segment_transformation.test_transformation", "property_rename.#", "1"),
+				),
+			},
+			// EQUIVALENT FQL SHOULDN'T CHANGE ANYTHING
+			{
+				Config:   testAccSegmentTransformationResourceBasicConfig(sourceSlug, name, "event='Order Completed'", "Order Placed"),
+				PlanOnly: true,
+			},
+			// CHANGE EVENT NAME
+			{
+				Config: testAccSegmentTransformationResourceBasicConfig(sourceSlug, name, "event = 'Order Completed'", "Order Submitted"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentTransformationExists("segment_transformation.test_transformation"),
+					resource.TestCheckResourceAttr("segment_transformation.test_transformation", "new_event_name", "Order Submitted"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "segment_transformation.test_transformation",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+			},
+		},
+	})
+}
+
+func testAccSegmentTransformationResourceBasicConfig(sourceSlug, name, condition, newEventName string) string {
+	return fmt.Sprintf(`
+resource "segment_source" "test_source" {
+  slug        = "%s"
+  name        = "%s"
+  source_slug = "javascript"
+  enabled     = false
+  settings {
+    track {
+    }
+    identify {
+    }
+    group {
+    }
+  }
+}
+
+resource "segment_transformation" "test_transformation" {
+  name           = "%s"
+  source_id      = segment_source.test_source.id
+  if             = "%s"
+  new_event_name = "%s"
+
+  property_rename {
+    old_name = "properties.total"
+    new_name = "properties.revenue"
+  }
+}
+`, sourceSlug, sourceSlug, name, condition, newEventName)
+}
+
+func testAccCheckSegmentTransformationExists(resource string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		apiClient := testAccProvider.Meta().(*segment.Client)
+
+		_, err := apiClient.GetTransformation(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckSegmentTransformationDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*segment.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "segment_transformation" {
+			continue
+		}
+		transformationID := rs.Primary.ID
+
+		_, err := apiClient.GetTransformation(transformationID)
+		if err == nil {
+			return fmt.Errorf("Transformation still exists")
+		}
+		notFoundErr := "not found"
+		expectedErr := regexp.MustCompile(notFoundErr)
+		if !expectedErr.Match([]byte(err.Error())) {
+			return fmt.Errorf("expected %s, got %s", notFoundErr, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+package resources
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceUserGroupMembers() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUserGroupMembersCreate,
+		ReadContext:   resourceUserGroupMembersRead,
+		UpdateContext: resourceUserGroupMembersUpdate,
+		DeleteContext: resourceUserGroupMembersDelete,
+
+		Schema: map[string]*schema.Schema{
+			"group_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identifier of the user group",
+			},
+			"emails": &schema.Schema{
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "Email addresses of the members, anyone not already in the workspace is sent an invite",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"authoritative": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Flag for whether or not this resource manages every member of the group, removing anyone not in `emails`. When false only the listed members are added and removed, so several resources can share a group",
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUserGroupMembersImport,
+		},
+	}
+}
+
+func resourceUserGroupMembersImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set("group_id", d.Id()); err != nil {
+		return nil, err
+	}
+	if err := d.Set("authoritative", true); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceUserGroupMembersCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	userGroupID := d.Get("group_id").(string)
+	emails := expandStringSet(d.Get("emails").(*schema.Set))
+
+	var err error
+	if d.Get("authoritative").(bool) {
+		err = c.ReplaceUserGroupMembers(userGroupID, emails)
+	} else {
+		err = c.AddUserGroupMembers(userGroupID, emails)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(userGroupID)
+
+	resourceUserGroupMembersRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceUserGroupMembersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	userGroupID := d.Id()
+
+	members, err := c.ListUserGroupMembers(userGroupID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Emails are case insensitive, keep the casing used in the configuration
+	configured := make(map[string]string)
+	for _, email := range expandStringSet(d.Get("emails").(*schema.Set)) {
+		configured[strings.ToLower(email)] = email
+	}
+
+	emails := make([]string, 0, len(members))
+	for _, member := range members {
+		email, ok := configured[strings.ToLower(member)]
+		if !ok {
+			// Members added outside of this resource are only tracked when
+			// it's authoritative
+			if !d.Get("authoritative").(bool) {
+				continue
+			}
+			email = member
+		}
+		emails = append(emails, email)
+	}
+
+	if err := d.Set("group_id", userGroupID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("emails", emails); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceUserGroupMembersUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	userGroupID := d.Id()
+
+	if d.HasChange("emails") || d.HasChange("authoritative") {
+		o, n := d.GetChange("emails")
+		oldEmails := o.(*schema.Set)
+		newEmails := n.(*schema.Set)
+
+		if d.Get("authoritative").(bool) {
+			err := c.ReplaceUserGroupMembers(userGroupID, expandStringSet(newEmails))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			err := c.RemoveUserGroupMembers(userGroupID, expandStringSet(oldEmails.Difference(newEmails)))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			added := expandStringSet(newEmails.Difference(oldEmails))
+			if len(added) > 0 {
+				err = c.AddUserGroupMembers(userGroupID, added)
+				if err != nil {
+					return diag.FromErr(err)
+				}
+			}
+		}
+	}
+
+	return resourceUserGroupMembersRead(ctx, d, m)
+}
+
+func resourceUserGroupMembersDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	userGroupID := d.Id()
+
+	var err error
+	if d.Get("authoritative").(bool) {
+		err = c.ReplaceUserGroupMembers(userGroupID, []string{})
+	} else {
+		err = c.RemoveUserGroupMembers(userGroupID, expandStringSet(d.Get("emails").(*schema.Set)))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,108 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceUserGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUserGroupCreate,
+		ReadContext:   resourceUserGroupRead,
+		UpdateContext: resourceUserGroupUpdate,
+		DeleteContext: resourceUserGroupDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the user group",
+			},
+			"member_count": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of users in the group",
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceUserGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	name := d.Get("name").(string)
+
+	userGroup, err := c.CreateUserGroup(name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s", *userGroup.ID))
+
+	resourceUserGroupRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceUserGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	userGroupID := d.Id()
+
+	userGroup, err := c.GetUserGroup(userGroupID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("name", userGroup.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("member_count", userGroup.MemberCount); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceUserGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	userGroupID := d.Id()
+
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+
+		_, err := c.UpdateUserGroup(userGroupID, name)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceUserGroupRead(ctx, d, m)
+}
+
+func resourceUserGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	userGroupID := d.Id()
+
+	_, err := c.DeleteUserGroup(userGroupID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,129 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSegmentUserGroupResource(t *testing.T) {
+
+	name := strings.ToLower(acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))
+	name2 := strings.ToLower(acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))
+	email := fmt.Sprintf("%s@example.com", strings.ToLower(acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)))
+	email2 := fmt.Sprintf("%s@example.com", strings.ToLower(acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSegmentUserGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSegmentUserGroupResourceBasicConfig(name, email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentUserGroupExists("segment_user_group.test_group"),
+					resource.TestCheckResourceAttr("segment_user_group.test_group", "name", name),
+					resource.TestCheckResourceAttr("segment_user_group_members.test_members", "emails.#", "1"),
+				),
+			},
+			// RENAME AND ADD MEMBERS
+			{
+				Config: testAccSegmentUserGroupResourceFullConfig(name2, email, email2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentUserGroupExists("segment_user_group.test_group"),
+					resource.TestCheckResourceAttr("segment_user_group.test_group", "name", name2),
+					resource.TestCheckResourceAttr("segment_user_group_members.test_members", "emails.#", "1"),
+					resource.TestCheckResourceAttr("segment_user_group_members.test_extra_members", "emails.#", "1"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "segment_user_group.test_group",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"member_count"},
+			},
+		},
+	})
+}
+
+func testAccSegmentUserGroupResourceBasicConfig(name, email string) string {
+	return fmt.Sprintf(`
+resource "segment_user_group" "test_group" {
+  name = "%s"
+}
+
+resource "segment_user_group_members" "test_members" {
+  group_id = segment_user_group.test_group.id
+  emails   = ["%s"]
+}
+`, name, email)
+}
+
+func testAccSegmentUserGroupResourceFullConfig(name, email, email2 string) string {
+	return fmt.Sprintf(`
+resource "segment_user_group" "test_group" {
+  name = "%s"
+}
+
+resource "segment_user_group_members" "test_members" {
+  group_id      = segment_user_group.test_group.id
+  emails        = ["%s"]
+  authoritative = false
+}
+
+resource "segment_user_group_members" "test_extra_members" {
+  group_id      = segment_user_group.test_group.id
+  emails        = ["%s"]
+  authoritative = false
+}
+`, name, email, email2)
+}
+
+func testAccCheckSegmentUserGroupExists(resource string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		apiClient := testAccProvider.Meta().(*segment.Client)
+
+		_, err := apiClient.GetUserGroup(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckSegmentUserGroupDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*segment.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "segment_user_group" {
+			continue
+		}
+		userGroupID := rs.Primary.ID
+
+		_, err := apiClient.GetUserGroup(userGroupID)
+		if err == nil {
+			return fmt.Errorf("User group still exists")
+		}
+		notFoundErr := "not found"
+		expectedErr := regexp.MustCompile(notFoundErr)
+		if !expectedErr.Match([]byte(err.Error())) {
+			return fmt.Errorf("expected %s, got %s", notFoundErr, err)
+		}
+	}
+
+	return nil
+}
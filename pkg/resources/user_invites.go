@@ -0,0 +1,160 @@
+package resources
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceUserInvite() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUserInviteCreate,
+		ReadContext:   resourceUserInviteRead,
+		UpdateContext: resourceUserInviteUpdate,
+		DeleteContext: resourceUserInviteDelete,
+
+		Schema: map[string]*schema.Schema{
+			"email": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Email address to invite to the workspace",
+			},
+			"permission": &schema.Schema{
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "Permissions the user is granted when accepting the invite, changing them re-sends a pending invite while changes after the invite is accepted should be made with `segment_user_permission`",
+				Elem: &schema.Resource{
+					Schema: permissionSchema(),
+				},
+			},
+			"remove_user_on_destroy": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Flag for whether or not to remove the user from the workspace when this resource is destroyed after the invite has been accepted",
+			},
+			"accepted": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether or not the invite has been accepted",
+			},
+			"user_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identifier of the user once the invite has been accepted",
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		// Pending invites can't be edited so are sent again, permissions
+		// aren't returned by the API so imported invites are left alone
+		CustomizeDiff: customdiff.ForceNewIf("permission", func(ctx context.Context, d *schema.ResourceDiff, m interface{}) bool {
+			o, _ := d.GetChange("permission")
+			return !d.Get("accepted").(bool) && len(o.([]interface{})) > 0
+		}),
+	}
+}
+
+func resourceUserInviteCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	email := d.Get("email").(string)
+	permissions := mapToPermissions(d.Get("permission").([]interface{}))
+
+	err := c.CreateInvite(email, permissions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(email)
+
+	resourceUserInviteRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceUserInviteRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	email := d.Id()
+
+	if err := d.Set("email", email); err != nil {
+		return diag.FromErr(err)
+	}
+
+	invites, err := c.ListInvites()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for _, invite := range invites {
+		if strings.EqualFold(invite, email) {
+			if err := d.Set("accepted", false); err != nil {
+				return diag.FromErr(err)
+			}
+			if err := d.Set("user_id", ""); err != nil {
+				return diag.FromErr(err)
+			}
+			return diags
+		}
+	}
+
+	// Accepted invites disappear from the list of invites, the user will
+	// have joined the workspace instead
+	user, err := c.GetUserByEmail(email)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("accepted", true); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("user_id", user.ID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceUserInviteUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceUserInviteRead(ctx, d, m)
+}
+
+func resourceUserInviteDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*segment.Client)
+
+	var diags diag.Diagnostics
+
+	email := d.Id()
+
+	if d.Get("accepted").(bool) {
+		if d.Get("remove_user_on_destroy").(bool) {
+			_, err := c.DeleteUser(d.Get("user_id").(string))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		return diags
+	}
+
+	_, err := c.DeleteInvite(email)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
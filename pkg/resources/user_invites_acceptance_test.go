@@ -0,0 +1,122 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSegmentUserInviteResource(t *testing.T) {
+
+	email := fmt.Sprintf("%s@example.com", strings.ToLower(acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)))
+	workspaceID := os.Getenv("SEGMENT_WORKSPACE_ID")
+	roleID := os.Getenv("SEGMENT_ROLE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckPermissions(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSegmentUserInviteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSegmentUserInviteResourceBasicConfig(email, roleID, workspaceID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentUserInviteExists("segment_user_invite.test_invite"),
+					resource.TestCheckResourceAttr("segment_user_invite.test_invite", "email", email),
+					resource.TestCheckResourceAttr("segment_user_invite.test_invite", "accepted", "false"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "segment_user_invite.test_invite",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"permission", "remove_user_on_destroy"},
+			},
+		},
+	})
+}
+
+func testAccPreCheckPermissions(t *testing.T) {
+	if v := os.Getenv("SEGMENT_WORKSPACE_ID"); v == "" {
+		t.Fatal("SEGMENT_WORKSPACE_ID must be set for acceptance tests of permissions")
+	}
+	if v := os.Getenv("SEGMENT_ROLE_ID"); v == "" {
+		t.Fatal("SEGMENT_ROLE_ID must be set for acceptance tests of permissions")
+	}
+}
+
+func testAccSegmentUserInviteResourceBasicConfig(email, roleID, workspaceID string) string {
+	return fmt.Sprintf(`
+resource "segment_user_invite" "test_invite" {
+  email = "%s"
+  permission {
+    role_id = "%s"
+    resource {
+      id   = "%s"
+      type = "WORKSPACE"
+    }
+  }
+}
+`, email, roleID, workspaceID)
+}
+
+func testAccCheckSegmentUserInviteExists(resource string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		apiClient := testAccProvider.Meta().(*segment.Client)
+
+		pending, err := isInvitePending(apiClient, rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
+		}
+		if !pending {
+			return fmt.Errorf("Invite for %s not found", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckSegmentUserInviteDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*segment.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "segment_user_invite" {
+			continue
+		}
+
+		pending, err := isInvitePending(apiClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if pending {
+			return fmt.Errorf("Invite still exists")
+		}
+	}
+
+	return nil
+}
+
+func isInvitePending(apiClient *segment.Client, email string) (bool, error) {
+	invites, err := apiClient.ListInvites()
+	if err != nil {
+		return false, err
+	}
+	for _, invite := range invites {
+		if strings.EqualFold(invite, email) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,22 @@
+package resources
+
+import (
+	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceUserPermission() *schema.Resource {
+	return permissionsResource(permissionsHolder{
+		idKey:                 "user_id",
+		idDescription:         "Identifier of the user",
+		permissionDescription: "Permissions granted directly to the user, this resource manages every permission of the user apart from those inherited from groups",
+		get: func(c *segment.Client, userID string) ([]segment.Permission, error) {
+			user, err := c.GetUser(userID)
+			if err != nil {
+				return nil, err
+			}
+			return user.Permissions, nil
+		},
+		replace: (*segment.Client).ReplaceUserPermissions,
+	})
+}
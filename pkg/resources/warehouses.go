@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/gthesheep/terraform-provider-segment/pkg/segment"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -84,11 +85,37 @@ func ResourceWarehouse() *schema.Resource {
 		},
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceWarehouseImport,
 		},
 	}
 }
 
+// resourceWarehouseImport accepts the ID or the name of the warehouse, a name
+// can also be given as name:<warehouse-name> if it looks like an ID.
+func resourceWarehouseImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*segment.Client)
+
+	name := strings.TrimPrefix(d.Id(), "name:")
+	if name == d.Id() {
+		warehouses, err := c.ListWarehouses()
+		if err != nil {
+			return nil, err
+		}
+		for _, warehouse := range warehouses {
+			if *warehouse.ID == d.Id() {
+				return []*schema.ResourceData{d}, nil
+			}
+		}
+	}
+
+	warehouse, err := c.GetWarehouseByName(name)
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(*warehouse.ID)
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceWarehouseCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*segment.Client)
 
@@ -125,7 +152,6 @@ func mapToWarehouseSettings(settings []interface{}) segment.WarehouseSettings {
 		Username: actualSettings["username"].(string),
 		Password: actualSettings["password"].(string),
 	}
-	return segment.WarehouseSettings{}
 }
 
 func flattenWarehouseSettings(warehouseSettings segment.WarehouseSettings) []interface{} {
@@ -54,6 +54,14 @@ func TestAccSegmentWarehouseResource(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"settings.0.password"},
 			},
+			// IMPORT BY NAME
+			{
+				ResourceName:            "segment_warehouse.test_warehouse",
+				ImportState:             true,
+				ImportStateId:           name2,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"settings.0.password"},
+			},
 		},
 	})
 }
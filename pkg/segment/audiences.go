@@ -0,0 +1,113 @@
+package segment
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+var (
+	AudienceTypes = []string{
+		"ACCOUNTS",
+		"USERS",
+	}
+)
+
+type AudienceDefinition struct {
+	Query string `json:"query"`
+	Type  string `json:"type"`
+}
+
+type Audience struct {
+	ID          *string            `json:"id,omitempty"`
+	SpaceID     string             `json:"spaceId,omitempty"`
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	Key         string             `json:"key,omitempty"`
+	Enabled     bool               `json:"enabled"`
+	Definition  AudienceDefinition `json:"definition"`
+	Status      string             `json:"status,omitempty"`
+}
+
+type AudienceResponse struct {
+	Audience Audience `json:"audience"`
+}
+
+type AudienceResponseData struct {
+	Data AudienceResponse `json:"data"`
+}
+
+type AudienceRequest struct {
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	Enabled     bool               `json:"enabled"`
+	Definition  AudienceDefinition `json:"definition"`
+}
+
+func (c *Client) GetAudience(spaceID string, audienceID string) (*Audience, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/spaces/%s/audiences/%s", c.HostURL, spaceID, audienceID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	audienceResponseData := AudienceResponseData{}
+	err = json.Unmarshal(body, &audienceResponseData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &audienceResponseData.Data.Audience, nil
+}
+
+func (c *Client) CreateAudience(spaceID string, audience AudienceRequest) (*Audience, error) {
+	return c.sendAudience("POST", fmt.Sprintf("%s/spaces/%s/audiences", c.HostURL, spaceID), audience)
+}
+
+func (c *Client) UpdateAudience(spaceID string, audienceID string, audience AudienceRequest) (*Audience, error) {
+	return c.sendAudience("PATCH", fmt.Sprintf("%s/spaces/%s/audiences/%s", c.HostURL, spaceID, audienceID), audience)
+}
+
+func (c *Client) sendAudience(method string, requestURL string, audience AudienceRequest) (*Audience, error) {
+	audienceData, err := json.Marshal(audience)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(method, requestURL, strings.NewReader(string(audienceData)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	audienceResponseData := AudienceResponseData{}
+	err = json.Unmarshal(body, &audienceResponseData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &audienceResponseData.Data.Audience, nil
+}
+
+func (c *Client) DeleteAudience(spaceID string, audienceID string) (string, error) {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/spaces/%s/audiences/%s", c.HostURL, spaceID, audienceID), nil)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return "", err
+	}
+
+	return "", err
+}
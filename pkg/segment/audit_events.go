@@ -0,0 +1,73 @@
+package segment
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+type AuditEvent struct {
+	ID           string `json:"id"`
+	Timestamp    string `json:"timestamp"`
+	Type         string `json:"type"`
+	Actor        string `json:"actor"`
+	ActorEmail   string `json:"actorEmail"`
+	ResourceID   string `json:"resourceId"`
+	ResourceType string `json:"resourceType"`
+	ResourceName string `json:"resourceName"`
+}
+
+type AuditEventsResponse struct {
+	AuditEvents []AuditEvent `json:"auditEvents"`
+	Pagination  Pagination   `json:"pagination"`
+}
+
+type AuditEventsResponseData struct {
+	Data AuditEventsResponse `json:"data"`
+}
+
+type AuditEventsQuery struct {
+	StartTime    string
+	EndTime      string
+	ResourceID   string
+	ResourceType string
+}
+
+// ListAuditEvents lists the audit trail of the workspace, empty fields of the
+// query aren't filtered on.
+func (c *Client) ListAuditEvents(query AuditEventsQuery) ([]AuditEvent, error) {
+	var auditEvents []AuditEvent
+
+	values := url.Values{}
+	if query.StartTime != "" {
+		values.Set("startTime", query.StartTime)
+	}
+	if query.EndTime != "" {
+		values.Set("endTime", query.EndTime)
+	}
+	if query.ResourceID != "" {
+		values.Set("resourceId", query.ResourceID)
+	}
+	if query.ResourceType != "" {
+		values.Set("resourceType", query.ResourceType)
+	}
+	path := "/audit-events"
+	if len(values) > 0 {
+		path = fmt.Sprintf("%s?%s", path, values.Encode())
+	}
+
+	err := c.listAll(path, func(body []byte) (*Pagination, error) {
+		auditEventsResponseData := AuditEventsResponseData{}
+		err := json.Unmarshal(body, &auditEventsResponseData)
+		if err != nil {
+			return nil, err
+		}
+		auditEvents = append(auditEvents, auditEventsResponseData.Data.AuditEvents...)
+		return &auditEventsResponseData.Data.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return auditEvents, nil
+}
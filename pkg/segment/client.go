@@ -12,6 +12,7 @@ type Client struct {
 	HostURL    string
 	HTTPClient *http.Client
 	Token      string
+	UserAgent  string
 }
 
 type Workspace struct {
@@ -25,7 +26,7 @@ type AuthResponseData struct {
 }
 
 type AuthResponse struct {
-	Data AuthResponseData `json:"data`
+	Data AuthResponseData `json:"data"`
 }
 
 func NewClient(apiURL string, token *string) (*Client, error) {
@@ -59,6 +60,9 @@ func (c *Client) doRequest(req *http.Request) ([]byte, error) {
 	req.Header.Add("Accept", "application/json")
 	req.Header.Add("Content-Type", "application/json")
 	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", c.Token))
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
 
 	res, err := c.HTTPClient.Do(req)
 
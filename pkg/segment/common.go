@@ -1,5 +1,14 @@
 package segment
 
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const pageSize = 100
+
 type Logo struct {
 	Default string `json:"default"`
 	Mark    string `json:"mark"`
@@ -13,12 +22,12 @@ type Pagination struct {
 }
 
 type IntegrationOption struct {
-	Name         string `json:"name"`
-	Type         string `json:"type"`
-	Required     bool   `json:"required"`
-	Description  string `json:"description"`
-	DefaultValue string `json:"defaultValue"`
-	Label        string `json:"label"`
+	Name         string      `json:"name"`
+	Type         string      `json:"type"`
+	Required     bool        `json:"required"`
+	Description  string      `json:"description"`
+	DefaultValue interface{} `json:"defaultValue"`
+	Label        string      `json:"label"`
 }
 
 var (
@@ -211,3 +220,40 @@ var (
 		"atatus",
 	}
 )
+
+// listAll requests every page of a paginated list endpoint, passing each
+// response body to handlePage, which returns the pagination of that page.
+func (c *Client) listAll(path string, handlePage func(body []byte) (*Pagination, error)) error {
+	separator := "?"
+	if strings.Contains(path, "?") {
+		separator = "&"
+	}
+
+	cursor := ""
+	for {
+		requestURL := fmt.Sprintf("%s%s%spagination.count=%d", c.HostURL, path, separator, pageSize)
+		if cursor != "" {
+			requestURL = fmt.Sprintf("%s&pagination.cursor=%s", requestURL, url.QueryEscape(cursor))
+		}
+
+		req, err := http.NewRequest("GET", requestURL, nil)
+		if err != nil {
+			return err
+		}
+
+		body, err := c.doRequest(req)
+		if err != nil {
+			return err
+		}
+
+		pagination, err := handlePage(body)
+		if err != nil {
+			return err
+		}
+
+		if pagination == nil || pagination.Next == nil || *pagination.Next == "" {
+			return nil
+		}
+		cursor = *pagination.Next
+	}
+}
@@ -0,0 +1,115 @@
+package segment
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+var (
+	ComputedTraitAggregationFunctions = []string{
+		"avg",
+		"max",
+		"min",
+		"sum",
+	}
+)
+
+type ComputedTraitDefinition struct {
+	Query    string `json:"query"`
+	Type     string `json:"type"`
+	SourceID string `json:"sourceId,omitempty"`
+}
+
+type ComputedTrait struct {
+	ID          *string                 `json:"id,omitempty"`
+	SpaceID     string                  `json:"spaceId,omitempty"`
+	Name        string                  `json:"name"`
+	Description string                  `json:"description"`
+	Key         string                  `json:"key,omitempty"`
+	Enabled     bool                    `json:"enabled"`
+	Definition  ComputedTraitDefinition `json:"definition"`
+}
+
+type ComputedTraitResponse struct {
+	ComputedTrait ComputedTrait `json:"computedTrait"`
+}
+
+type ComputedTraitResponseData struct {
+	Data ComputedTraitResponse `json:"data"`
+}
+
+type ComputedTraitRequest struct {
+	Name        string                  `json:"name"`
+	Description string                  `json:"description"`
+	Enabled     bool                    `json:"enabled"`
+	Definition  ComputedTraitDefinition `json:"definition"`
+}
+
+func (c *Client) GetComputedTrait(spaceID string, computedTraitID string) (*ComputedTrait, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/spaces/%s/computed-traits/%s", c.HostURL, spaceID, computedTraitID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	computedTraitResponseData := ComputedTraitResponseData{}
+	err = json.Unmarshal(body, &computedTraitResponseData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &computedTraitResponseData.Data.ComputedTrait, nil
+}
+
+func (c *Client) CreateComputedTrait(spaceID string, computedTrait ComputedTraitRequest) (*ComputedTrait, error) {
+	return c.sendComputedTrait("POST", fmt.Sprintf("%s/spaces/%s/computed-traits", c.HostURL, spaceID), computedTrait)
+}
+
+func (c *Client) UpdateComputedTrait(spaceID string, computedTraitID string, computedTrait ComputedTraitRequest) (*ComputedTrait, error) {
+	return c.sendComputedTrait("PATCH", fmt.Sprintf("%s/spaces/%s/computed-traits/%s", c.HostURL, spaceID, computedTraitID), computedTrait)
+}
+
+func (c *Client) sendComputedTrait(method string, requestURL string, computedTrait ComputedTraitRequest) (*ComputedTrait, error) {
+	computedTraitData, err := json.Marshal(computedTrait)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(method, requestURL, strings.NewReader(string(computedTraitData)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	computedTraitResponseData := ComputedTraitResponseData{}
+	err = json.Unmarshal(body, &computedTraitResponseData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &computedTraitResponseData.Data.ComputedTrait, nil
+}
+
+func (c *Client) DeleteComputedTrait(spaceID string, computedTraitID string) (string, error) {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/spaces/%s/computed-traits/%s", c.HostURL, spaceID, computedTraitID), nil)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return "", err
+	}
+
+	return "", err
+}
@@ -0,0 +1,78 @@
+package segment
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+var (
+	DeliveryMetricsGranularities = []string{
+		"DAY",
+		"HOUR",
+		"MINUTE",
+	}
+)
+
+type DeliveryMetricBreakdown struct {
+	MetricName string `json:"metricName"`
+	Value      int    `json:"value"`
+}
+
+type DeliveryMetric struct {
+	MetricName string                    `json:"metricName"`
+	Total      int                       `json:"total"`
+	Breakdown  []DeliveryMetricBreakdown `json:"breakdown"`
+}
+
+type DeliveryMetricsSummary struct {
+	SourceID              string           `json:"sourceId"`
+	DestinationMetadataID string           `json:"destinationMetadataId"`
+	Metrics               []DeliveryMetric `json:"metrics"`
+}
+
+type DeliveryMetricsResponse struct {
+	DeliveryMetricsSummary DeliveryMetricsSummary `json:"deliveryMetricsSummary"`
+}
+
+type DeliveryMetricsResponseData struct {
+	Data DeliveryMetricsResponse `json:"data"`
+}
+
+type DeliveryMetricsQuery struct {
+	SourceID    string
+	StartTime   string
+	EndTime     string
+	Granularity string
+}
+
+// GetDestinationDeliveryMetrics summarises how events from the source were
+// delivered to the destination between the start and end times, both RFC 3339.
+func (c *Client) GetDestinationDeliveryMetrics(destinationID string, query DeliveryMetricsQuery) (*DeliveryMetricsSummary, error) {
+	values := url.Values{}
+	values.Set("sourceId", query.SourceID)
+	values.Set("startTime", query.StartTime)
+	values.Set("endTime", query.EndTime)
+	if query.Granularity != "" {
+		values.Set("granularity", query.Granularity)
+	}
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/destinations/%s/delivery-metrics?%s", c.HostURL, destinationID, values.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveryMetricsResponseData := DeliveryMetricsResponseData{}
+	err = json.Unmarshal(body, &deliveryMetricsResponseData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deliveryMetricsResponseData.Data.DeliveryMetricsSummary, nil
+}
@@ -0,0 +1,106 @@
+package segment
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type DestinationSubscription struct {
+	ID                 *string                `json:"id,omitempty"`
+	DestinationID      string                 `json:"destinationId,omitempty"`
+	Name               string                 `json:"name"`
+	ActionID           string                 `json:"actionId,omitempty"`
+	ActionSlug         string                 `json:"actionSlug,omitempty"`
+	Enabled            bool                   `json:"enabled"`
+	Trigger            string                 `json:"trigger"`
+	Settings           map[string]interface{} `json:"settings"`
+	ModelID            string                 `json:"modelId,omitempty"`
+	ReverseETLSchedule *ReverseETLSchedule    `json:"reverseETLSchedule,omitempty"`
+}
+
+type DestinationSubscriptionResponse struct {
+	DestinationSubscription DestinationSubscription `json:"destinationSubscription"`
+}
+
+type DestinationSubscriptionResponseData struct {
+	Data DestinationSubscriptionResponse `json:"data"`
+}
+
+func (c *Client) GetDestinationSubscription(destinationID string, subscriptionID string) (*DestinationSubscription, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/destinations/%s/subscriptions/%s", c.HostURL, destinationID, subscriptionID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptionResponseData := DestinationSubscriptionResponseData{}
+	err = json.Unmarshal(body, &subscriptionResponseData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &subscriptionResponseData.Data.DestinationSubscription, nil
+}
+
+func (c *Client) CreateDestinationSubscription(destinationID string, subscription DestinationSubscription) (*DestinationSubscription, error) {
+	return c.sendDestinationSubscription("POST", fmt.Sprintf("%s/destinations/%s/subscriptions", c.HostURL, destinationID), subscription)
+}
+
+// UpdateDestinationSubscription updates the subscription, the action and
+// model it was created with can't be changed.
+func (c *Client) UpdateDestinationSubscription(destinationID string, subscriptionID string, subscription DestinationSubscription) (*DestinationSubscription, error) {
+	subscription.ActionID = ""
+	subscription.ModelID = ""
+	return c.sendDestinationSubscription("PATCH", fmt.Sprintf("%s/destinations/%s/subscriptions/%s", c.HostURL, destinationID, subscriptionID), subscription)
+}
+
+func (c *Client) sendDestinationSubscription(method string, requestURL string, subscription DestinationSubscription) (*DestinationSubscription, error) {
+	subscription.ID = nil
+	subscription.DestinationID = ""
+	subscription.ActionSlug = ""
+	if subscription.Settings == nil {
+		subscription.Settings = map[string]interface{}{}
+	}
+	subscriptionData, err := json.Marshal(subscription)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(method, requestURL, strings.NewReader(string(subscriptionData)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptionResponseData := DestinationSubscriptionResponseData{}
+	err = json.Unmarshal(body, &subscriptionResponseData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &subscriptionResponseData.Data.DestinationSubscription, nil
+}
+
+func (c *Client) DeleteDestinationSubscription(destinationID string, subscriptionID string) (string, error) {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/destinations/%s/subscriptions/%s", c.HostURL, destinationID, subscriptionID), nil)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return "", err
+	}
+
+	return "", err
+}
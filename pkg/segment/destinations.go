@@ -8,13 +8,13 @@ import (
 )
 
 type DestinationMetadata struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	Slug        string `json:"slug"`
-	Description string `json:"description"`
-	Logos       Logo   `json:"logos"`
-	// 	Options     []IntegrationOption `json:"options"`
-	Categories []string `json:"categories"`
+	ID          string              `json:"id"`
+	Name        string              `json:"name"`
+	Slug        string              `json:"slug"`
+	Description string              `json:"description"`
+	Logos       Logo                `json:"logos"`
+	Options     []IntegrationOption `json:"options"`
+	Categories  []string            `json:"categories"`
 }
 
 type Destination struct {
@@ -34,6 +34,15 @@ type DestinationResponseData struct {
 	Data DestinationResponse `json:"data"`
 }
 
+type DestinationsResponse struct {
+	Destinations []Destination `json:"destinations"`
+	Pagination   Pagination    `json:"pagination"`
+}
+
+type DestinationsResponseData struct {
+	Data DestinationsResponse `json:"data"`
+}
+
 type DestinationRequest struct {
 	ID         *string                `json:"id,omitempty"`
 	SourceID   string                 `json:"sourceId"`
@@ -64,12 +73,21 @@ func (c *Client) GetDestination(destinationID string) (*Destination, error) {
 }
 
 func (c *Client) CreateDestination(sourceID string, enabled bool, name string, destinationSlug string, settings map[string]interface{}) (*Destination, error) {
-	destinationMetadata, _ := c.GetDestinationMetadataFromCatalog(destinationSlug)
+	destinationMetadata, err := c.GetDestinationMetadataFromCatalog(destinationSlug)
+	if err != nil {
+		return nil, err
+	}
 
+	return c.CreateDestinationFromMetadata(sourceID, enabled, name, destinationMetadata.ID, settings)
+}
+
+// CreateDestinationFromMetadata creates a destination from a catalog
+// identifier rather than a slug, i.e. for destination functions.
+func (c *Client) CreateDestinationFromMetadata(sourceID string, enabled bool, name string, metadataID string, settings map[string]interface{}) (*Destination, error) {
 	newDestination := DestinationRequest{
 		Enabled:    enabled,
 		Name:       name,
-		MetadataID: &destinationMetadata.ID,
+		MetadataID: &metadataID,
 		Settings:   settings,
 		SourceID:   sourceID,
 	}
@@ -141,3 +159,50 @@ func (c *Client) DeleteDestination(destinationID string) (string, error) {
 
 	return "", err
 }
+
+func (c *Client) ListDestinations() ([]Destination, error) {
+	var destinations []Destination
+
+	err := c.listAll("/destinations", func(body []byte) (*Pagination, error) {
+		destinationsResponseData := DestinationsResponseData{}
+		err := json.Unmarshal(body, &destinationsResponseData)
+		if err != nil {
+			return nil, err
+		}
+		destinations = append(destinations, destinationsResponseData.Data.Destinations...)
+		return &destinationsResponseData.Data.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return destinations, nil
+}
+
+// GetDestinationByName looks a destination up by name, only considering the
+// destinations of the source when sourceID isn't empty. Names aren't unique
+// so it fails if more than one destination has the name.
+func (c *Client) GetDestinationByName(sourceID string, name string) (*Destination, error) {
+	destinations, err := c.ListDestinations()
+	if err != nil {
+		return nil, err
+	}
+
+	var found []*Destination
+	var ids []string
+	for i, destination := range destinations {
+		if destination.Name != name || (sourceID != "" && destination.SourceID != sourceID) {
+			continue
+		}
+		found = append(found, &destinations[i])
+		ids = append(ids, *destination.ID)
+	}
+	if len(found) == 0 {
+		return nil, fmt.Errorf("Destination %s not found", name)
+	}
+	if len(found) > 1 {
+		return nil, fmt.Errorf("More than one destination is named %s, use one of their IDs instead: %s", name, strings.Join(ids, ", "))
+	}
+
+	return found[0], nil
+}
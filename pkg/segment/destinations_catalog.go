@@ -15,58 +15,64 @@ type DestinationsCatalogResponse struct {
 	Data DestinationsCatalogResponseData `json:"data"`
 }
 
-func (c *Client) GetDestinationMetadataFromCatalog(destinationSlug string) (*DestinationMetadata, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/catalog/destinations?pagination.count=100", c.HostURL), nil)
+type DestinationMetadataResponse struct {
+	DestinationMetadata DestinationMetadata `json:"destinationMetadata"`
+}
 
-	if err != nil {
-		return nil, err
-	}
+type DestinationMetadataResponseData struct {
+	Data DestinationMetadataResponse `json:"data"`
+}
 
-	body, err := c.doRequest(req)
+func (c *Client) ListDestinationsCatalog() ([]DestinationMetadata, error) {
+	var catalog []DestinationMetadata
 
+	err := c.listAll("/catalog/destinations", func(body []byte) (*Pagination, error) {
+		destinationsCatalogResponse := DestinationsCatalogResponse{}
+		err := json.Unmarshal(body, &destinationsCatalogResponse)
+		if err != nil {
+			return nil, err
+		}
+		catalog = append(catalog, destinationsCatalogResponse.Data.DestinationsCatalog...)
+		return &destinationsCatalogResponse.Data.Pagination, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	destinationsCatalogResponse := DestinationsCatalogResponse{}
-	err = json.Unmarshal(body, &destinationsCatalogResponse)
+	return catalog, nil
+}
+
+func (c *Client) GetDestinationMetadataFromCatalog(destinationSlug string) (*DestinationMetadata, error) {
+	catalog, err := c.ListDestinationsCatalog()
 	if err != nil {
 		return nil, err
 	}
 
-	for i, destinationMetadata := range destinationsCatalogResponse.Data.DestinationsCatalog {
+	for i, destinationMetadata := range catalog {
 		if destinationMetadata.Slug == destinationSlug {
-			return &destinationsCatalogResponse.Data.DestinationsCatalog[i], nil
+			return &catalog[i], nil
 		}
 	}
 
-	for {
-		req, err := http.NewRequest("GET", fmt.Sprintf("%s/catalog/destinations?pagination.count=100&pagination.cursor=%s", c.HostURL, *destinationsCatalogResponse.Data.Pagination.Next), nil)
-		if err != nil {
-			return nil, err
-		}
-
-		body, err = c.doRequest(req)
-		if err != nil {
-			return nil, err
-		}
+	return nil, fmt.Errorf("Did not find destination %s", destinationSlug)
+}
 
-		destinationsCatalogResponse = DestinationsCatalogResponse{}
-		err = json.Unmarshal(body, &destinationsCatalogResponse)
-		if err != nil {
-			return nil, err
-		}
+func (c *Client) GetDestinationMetadata(destinationMetadataID string) (*DestinationMetadata, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/catalog/destinations/%s", c.HostURL, destinationMetadataID), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		for i, destinationMetadata := range destinationsCatalogResponse.Data.DestinationsCatalog {
-			if destinationMetadata.Slug == destinationSlug {
-				return &destinationsCatalogResponse.Data.DestinationsCatalog[i], nil
-			}
-		}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
 
-		if *destinationsCatalogResponse.Data.Pagination.Next == "" {
-			break
-		}
+	destinationMetadataResponseData := DestinationMetadataResponseData{}
+	err = json.Unmarshal(body, &destinationMetadataResponseData)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, fmt.Errorf("Did not find destination %s", destinationSlug)
+	return &destinationMetadataResponseData.Data.DestinationMetadata, nil
 }
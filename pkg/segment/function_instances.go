@@ -0,0 +1,115 @@
+package segment
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type FunctionInstance struct {
+	ID            *string                `json:"id,omitempty"`
+	Name          string                 `json:"name"`
+	FunctionID    string                 `json:"functionId"`
+	IntegrationID string                 `json:"integrationId"`
+	Enabled       bool                   `json:"enabled"`
+	Settings      map[string]interface{} `json:"settings"`
+}
+
+type FunctionInstanceResponse struct {
+	FunctionInstance FunctionInstance `json:"insertFunctionInstance"`
+}
+
+type FunctionInstanceResponseData struct {
+	Data FunctionInstanceResponse `json:"data"`
+}
+
+type FunctionInstanceRequest struct {
+	Name          string                 `json:"name"`
+	FunctionID    string                 `json:"functionId,omitempty"`
+	IntegrationID string                 `json:"integrationId,omitempty"`
+	Enabled       bool                   `json:"enabled"`
+	Settings      map[string]interface{} `json:"settings"`
+}
+
+func (c *Client) GetFunctionInstance(instanceID string) (*FunctionInstance, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/insert-function-instances/%s", c.HostURL, instanceID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	instanceResponseData := FunctionInstanceResponseData{}
+	err = json.Unmarshal(body, &instanceResponseData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &instanceResponseData.Data.FunctionInstance, nil
+}
+
+func (c *Client) CreateFunctionInstance(functionID string, integrationID string, name string, enabled bool, settings map[string]interface{}) (*FunctionInstance, error) {
+	newInstance := FunctionInstanceRequest{
+		Name:          name,
+		FunctionID:    functionID,
+		IntegrationID: integrationID,
+		Enabled:       enabled,
+		Settings:      settings,
+	}
+	return c.sendFunctionInstance("POST", fmt.Sprintf("%s/insert-function-instances", c.HostURL), newInstance)
+}
+
+func (c *Client) UpdateFunctionInstance(instanceID string, name string, enabled bool, settings map[string]interface{}) (*FunctionInstance, error) {
+	updatedInstance := FunctionInstanceRequest{
+		Name:     name,
+		Enabled:  enabled,
+		Settings: settings,
+	}
+	return c.sendFunctionInstance("PATCH", fmt.Sprintf("%s/insert-function-instances/%s", c.HostURL, instanceID), updatedInstance)
+}
+
+func (c *Client) sendFunctionInstance(method string, requestURL string, instance FunctionInstanceRequest) (*FunctionInstance, error) {
+	if instance.Settings == nil {
+		instance.Settings = map[string]interface{}{}
+	}
+	instanceData, err := json.Marshal(instance)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(method, requestURL, strings.NewReader(string(instanceData)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	instanceResponseData := FunctionInstanceResponseData{}
+	err = json.Unmarshal(body, &instanceResponseData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &instanceResponseData.Data.FunctionInstance, nil
+}
+
+func (c *Client) DeleteFunctionInstance(instanceID string) (string, error) {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/insert-function-instances/%s", c.HostURL, instanceID), nil)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return "", err
+	}
+
+	return "", err
+}
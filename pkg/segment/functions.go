@@ -0,0 +1,240 @@
+package segment
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+var (
+	FunctionResourceTypes = []string{
+		"DESTINATION",
+		"INSERT_DESTINATION",
+		"SOURCE",
+	}
+	FunctionSettingTypes = []string{
+		"ARRAY",
+		"BOOLEAN",
+		"STRING",
+		"TEXT_MAP",
+	}
+)
+
+type FunctionSetting struct {
+	Name        string `json:"name"`
+	Label       string `json:"label"`
+	Description string `json:"description"`
+	Type        string `json:"type"`
+	Required    bool   `json:"required"`
+	Sensitive   bool   `json:"sensitive"`
+}
+
+type Function struct {
+	ID                *string           `json:"id,omitempty"`
+	DisplayName       string            `json:"displayName"`
+	Description       string            `json:"description"`
+	LogoURL           string            `json:"logoUrl"`
+	Code              string            `json:"code"`
+	Settings          []FunctionSetting `json:"settings"`
+	ResourceType      string            `json:"resourceType"`
+	CatalogID         string            `json:"catalogId"`
+	PreviewWebhookURL string            `json:"previewWebhookUrl"`
+	CreatedAt         string            `json:"createdAt"`
+}
+
+type FunctionResponse struct {
+	Function Function `json:"function"`
+}
+
+type FunctionResponseData struct {
+	Data FunctionResponse `json:"data"`
+}
+
+type FunctionRequest struct {
+	DisplayName  string            `json:"displayName"`
+	Description  string            `json:"description"`
+	LogoURL      string            `json:"logoUrl,omitempty"`
+	Code         string            `json:"code"`
+	Settings     []FunctionSetting `json:"settings"`
+	ResourceType string            `json:"resourceType,omitempty"`
+}
+
+type FunctionVersion struct {
+	ID         string `json:"id"`
+	FunctionID string `json:"functionId"`
+	Code       string `json:"code"`
+	CreatedAt  string `json:"createdAt"`
+	CreatedBy  string `json:"createdBy"`
+}
+
+type FunctionVersionResponse struct {
+	Version FunctionVersion `json:"version"`
+}
+
+type FunctionVersionResponseData struct {
+	Data FunctionVersionResponse `json:"data"`
+}
+
+type FunctionVersionsResponse struct {
+	Versions   []FunctionVersion `json:"versions"`
+	Pagination Pagination        `json:"pagination"`
+}
+
+type FunctionVersionsResponseData struct {
+	Data FunctionVersionsResponse `json:"data"`
+}
+
+type FunctionVersionRequest struct {
+	VersionID string `json:"versionId"`
+}
+
+func (c *Client) GetFunction(functionID string) (*Function, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/functions/%s", c.HostURL, functionID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	functionResponseData := FunctionResponseData{}
+	err = json.Unmarshal(body, &functionResponseData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &functionResponseData.Data.Function, nil
+}
+
+func (c *Client) CreateFunction(function FunctionRequest) (*Function, error) {
+	return c.sendFunction("POST", fmt.Sprintf("%s/functions", c.HostURL), function)
+}
+
+// UpdateFunction updates the code, settings and display details of the
+// function, the resource type can't be changed.
+func (c *Client) UpdateFunction(functionID string, function FunctionRequest) (*Function, error) {
+	function.ResourceType = ""
+	return c.sendFunction("PATCH", fmt.Sprintf("%s/functions/%s", c.HostURL, functionID), function)
+}
+
+func (c *Client) sendFunction(method string, requestURL string, function FunctionRequest) (*Function, error) {
+	if function.Settings == nil {
+		function.Settings = []FunctionSetting{}
+	}
+	functionData, err := json.Marshal(function)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(method, requestURL, strings.NewReader(string(functionData)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	functionResponseData := FunctionResponseData{}
+	err = json.Unmarshal(body, &functionResponseData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &functionResponseData.Data.Function, nil
+}
+
+func (c *Client) DeleteFunction(functionID string) (string, error) {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/functions/%s", c.HostURL, functionID), nil)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return "", err
+	}
+
+	return "", err
+}
+
+func (c *Client) ListFunctionVersions(functionID string) ([]FunctionVersion, error) {
+	var versions []FunctionVersion
+
+	err := c.listAll(fmt.Sprintf("/functions/%s/versions", functionID), func(body []byte) (*Pagination, error) {
+		versionsResponseData := FunctionVersionsResponseData{}
+		err := json.Unmarshal(body, &versionsResponseData)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, versionsResponseData.Data.Versions...)
+		return &versionsResponseData.Data.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return versions, nil
+}
+
+// SortFunctionVersions orders versions from the newest to the oldest, by the
+// time they were created.
+func SortFunctionVersions(versions []FunctionVersion) error {
+	created := make(map[string]time.Time, len(versions))
+	for _, version := range versions {
+		t, err := time.Parse(time.RFC3339, version.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("Unexpected creation time of function version %s: %s", version.ID, err)
+		}
+		created[version.ID] = t
+	}
+
+	sort.SliceStable(versions, func(i, j int) bool {
+		return created[versions[i].ID].After(created[versions[j].ID])
+	})
+	return nil
+}
+
+// RestoreFunctionVersion makes the code of a previous version the latest
+// code of the function, it still has to be deployed to take effect.
+func (c *Client) RestoreFunctionVersion(functionID string, versionID string) (*FunctionVersion, error) {
+	versionData, err := json.Marshal(FunctionVersionRequest{VersionID: versionID})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/functions/%s/versions", c.HostURL, functionID), strings.NewReader(string(versionData)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	versionResponseData := FunctionVersionResponseData{}
+	err = json.Unmarshal(body, &versionResponseData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &versionResponseData.Data.Version, nil
+}
+
+// DeployFunction deploys the latest code of the function to every instance.
+func (c *Client) DeployFunction(functionID string) error {
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/functions/%s/deploy", c.HostURL, functionID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	return err
+}
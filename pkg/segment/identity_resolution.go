@@ -0,0 +1,94 @@
+package segment
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+var (
+	IdentifierLimitFrequencies = []string{
+		"ANNUALLY",
+		"DAILY",
+		"EVER",
+		"MONTHLY",
+		"WEEKLY",
+	}
+)
+
+// IdentityResolutionIdentifier is an external ID type used to merge
+// profiles, identifiers with a lower priority number win when they conflict.
+type IdentityResolutionIdentifier struct {
+	Key       string `json:"key"`
+	Limit     int    `json:"limit"`
+	Frequency string `json:"frequency"`
+	Priority  int    `json:"priority"`
+}
+
+type IdentityResolution struct {
+	SpaceID     string                         `json:"spaceId,omitempty"`
+	Identifiers []IdentityResolutionIdentifier `json:"identifiers"`
+}
+
+type IdentityResolutionResponse struct {
+	IdentityResolution IdentityResolution `json:"identityResolution"`
+}
+
+type IdentityResolutionResponseData struct {
+	Data IdentityResolutionResponse `json:"data"`
+}
+
+type IdentityResolutionRequest struct {
+	Identifiers []IdentityResolutionIdentifier `json:"identifiers"`
+}
+
+func (c *Client) GetIdentityResolution(spaceID string) (*IdentityResolution, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/spaces/%s/identity-resolution", c.HostURL, spaceID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	identityResolutionResponseData := IdentityResolutionResponseData{}
+	err = json.Unmarshal(body, &identityResolutionResponseData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &identityResolutionResponseData.Data.IdentityResolution, nil
+}
+
+// ReplaceIdentityResolution sets the identifiers of the space, any identifier
+// not in the list stops being used for merging profiles.
+func (c *Client) ReplaceIdentityResolution(spaceID string, identifiers []IdentityResolutionIdentifier) (*IdentityResolution, error) {
+	if identifiers == nil {
+		identifiers = []IdentityResolutionIdentifier{}
+	}
+	identityResolutionData, err := json.Marshal(IdentityResolutionRequest{Identifiers: identifiers})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/spaces/%s/identity-resolution", c.HostURL, spaceID), strings.NewReader(string(identityResolutionData)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	identityResolutionResponseData := IdentityResolutionResponseData{}
+	err = json.Unmarshal(body, &identityResolutionResponseData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &identityResolutionResponseData.Data.IdentityResolution, nil
+}
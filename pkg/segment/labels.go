@@ -0,0 +1,111 @@
+package segment
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type LabelResponse struct {
+	Label Label `json:"label"`
+}
+
+type LabelResponseData struct {
+	Data LabelResponse `json:"data"`
+}
+
+type LabelsResponse struct {
+	Labels []Label `json:"labels"`
+}
+
+type LabelsResponseData struct {
+	Data LabelsResponse `json:"data"`
+}
+
+type LabelRequest struct {
+	Label Label `json:"label"`
+}
+
+func (c *Client) ListLabels() ([]Label, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/labels", c.HostURL), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	labelsResponseData := LabelsResponseData{}
+	err = json.Unmarshal(body, &labelsResponseData)
+	if err != nil {
+		return nil, err
+	}
+
+	return labelsResponseData.Data.Labels, nil
+}
+
+func (c *Client) GetLabel(key string, value string) (*Label, error) {
+	labels, err := c.ListLabels()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, label := range labels {
+		if label.Key == key && label.Value == value {
+			return &labels[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("Label %s:%s not found", key, value)
+}
+
+func (c *Client) CreateLabel(key string, value string, description string) (*Label, error) {
+	newLabel := LabelRequest{
+		Label: Label{
+			Key:         key,
+			Value:       value,
+			Description: description,
+		},
+	}
+
+	newLabelData, err := json.Marshal(newLabel)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/labels", c.HostURL), strings.NewReader(string(newLabelData)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	labelResponseData := LabelResponseData{}
+	err = json.Unmarshal(body, &labelResponseData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &labelResponseData.Data.Label, nil
+}
+
+func (c *Client) DeleteLabel(key string, value string) (string, error) {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/labels/%s/%s", c.HostURL, url.PathEscape(key), url.PathEscape(value)), nil)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return "", err
+	}
+
+	return "", err
+}
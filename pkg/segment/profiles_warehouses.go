@@ -0,0 +1,125 @@
+package segment
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type ProfilesWarehouse struct {
+	ID          *string `json:"id,omitempty"`
+	SpaceID     string  `json:"spaceId"`
+	WarehouseID string  `json:"warehouseId"`
+	Enabled     bool    `json:"enabled"`
+	SchemaName  string  `json:"schemaName"`
+}
+
+type ProfilesWarehouseResponse struct {
+	ProfilesWarehouse ProfilesWarehouse `json:"profilesWarehouse"`
+}
+
+type ProfilesWarehouseResponseData struct {
+	Data ProfilesWarehouseResponse `json:"data"`
+}
+
+type ProfilesWarehousesResponse struct {
+	ProfilesWarehouses []ProfilesWarehouse `json:"profilesWarehouses"`
+	Pagination         Pagination          `json:"pagination"`
+}
+
+type ProfilesWarehousesResponseData struct {
+	Data ProfilesWarehousesResponse `json:"data"`
+}
+
+type ProfilesWarehouseRequest struct {
+	WarehouseID string `json:"warehouseId,omitempty"`
+	Enabled     bool   `json:"enabled"`
+	SchemaName  string `json:"schemaName,omitempty"`
+}
+
+func (c *Client) ListProfilesWarehouses(spaceID string) ([]ProfilesWarehouse, error) {
+	var profilesWarehouses []ProfilesWarehouse
+
+	err := c.listAll(fmt.Sprintf("/spaces/%s/profiles-warehouses", spaceID), func(body []byte) (*Pagination, error) {
+		profilesWarehousesResponseData := ProfilesWarehousesResponseData{}
+		err := json.Unmarshal(body, &profilesWarehousesResponseData)
+		if err != nil {
+			return nil, err
+		}
+		profilesWarehouses = append(profilesWarehouses, profilesWarehousesResponseData.Data.ProfilesWarehouses...)
+		return &profilesWarehousesResponseData.Data.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return profilesWarehouses, nil
+}
+
+// GetProfilesWarehouse looks the profiles warehouse up in the space, there's
+// no endpoint to fetch a single one.
+func (c *Client) GetProfilesWarehouse(spaceID string, profilesWarehouseID string) (*ProfilesWarehouse, error) {
+	profilesWarehouses, err := c.ListProfilesWarehouses(spaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, profilesWarehouse := range profilesWarehouses {
+		if profilesWarehouse.ID != nil && *profilesWarehouse.ID == profilesWarehouseID {
+			return &profilesWarehouses[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("Profiles warehouse %s not found", profilesWarehouseID)
+}
+
+func (c *Client) CreateProfilesWarehouse(spaceID string, profilesWarehouse ProfilesWarehouseRequest) (*ProfilesWarehouse, error) {
+	return c.sendProfilesWarehouse("POST", fmt.Sprintf("%s/spaces/%s/profiles-warehouses", c.HostURL, spaceID), profilesWarehouse)
+}
+
+// UpdateProfilesWarehouse updates the profiles warehouse, the warehouse it
+// syncs to can't be changed.
+func (c *Client) UpdateProfilesWarehouse(spaceID string, profilesWarehouseID string, profilesWarehouse ProfilesWarehouseRequest) (*ProfilesWarehouse, error) {
+	profilesWarehouse.WarehouseID = ""
+	return c.sendProfilesWarehouse("PATCH", fmt.Sprintf("%s/spaces/%s/profiles-warehouses/%s", c.HostURL, spaceID, profilesWarehouseID), profilesWarehouse)
+}
+
+func (c *Client) sendProfilesWarehouse(method string, requestURL string, profilesWarehouse ProfilesWarehouseRequest) (*ProfilesWarehouse, error) {
+	profilesWarehouseData, err := json.Marshal(profilesWarehouse)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(method, requestURL, strings.NewReader(string(profilesWarehouseData)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	profilesWarehouseResponseData := ProfilesWarehouseResponseData{}
+	err = json.Unmarshal(body, &profilesWarehouseResponseData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &profilesWarehouseResponseData.Data.ProfilesWarehouse, nil
+}
+
+func (c *Client) DeleteProfilesWarehouse(spaceID string, profilesWarehouseID string) (string, error) {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/spaces/%s/profiles-warehouses/%s", c.HostURL, spaceID, profilesWarehouseID), nil)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return "", err
+	}
+
+	return "", err
+}
@@ -0,0 +1,167 @@
+package segment
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+var (
+	RegulationTypes = []string{
+		"DELETE_ARCHIVE_ONLY",
+		"DELETE_INTERNAL",
+		"DELETE_ONLY",
+		"SUPPRESS_ONLY",
+		"SUPPRESS_WITH_DELETE",
+		"SUPPRESS_WITH_DELETE_INTERNAL",
+		"UNSUPPRESS",
+	}
+	RegulationSubjectTypes = []string{
+		"OBJECT_ID",
+		"USER_ID",
+	}
+	RegulationStatuses = []string{
+		"FAILED",
+		"FINISHED",
+		"INITIALIZED",
+		"INVALID",
+		"NOT_SUPPORTED",
+		"PARTIAL_SUCCESS",
+		"RUNNING",
+	}
+	// RegulationPendingStatuses are the statuses a regulation can still move
+	// on from, every other status is final.
+	RegulationPendingStatuses = []string{
+		"INITIALIZED",
+		"RUNNING",
+	}
+)
+
+type Regulation struct {
+	ID            string `json:"id"`
+	WorkspaceID   string `json:"workspaceId"`
+	OverallStatus string `json:"overallStatus"`
+	CreatedAt     string `json:"createdAt"`
+	FinishedAt    string `json:"finishedAt"`
+}
+
+type RegulationResponse struct {
+	Regulation Regulation `json:"regulation"`
+}
+
+type RegulationResponseData struct {
+	Data RegulationResponse `json:"data"`
+}
+
+type RegulationsResponse struct {
+	Regulations []Regulation `json:"regulations"`
+	Pagination  Pagination   `json:"pagination"`
+}
+
+type RegulationsResponseData struct {
+	Data RegulationsResponse `json:"data"`
+}
+
+type RegulationRequest struct {
+	RegulationType string   `json:"regulationType"`
+	SubjectType    string   `json:"subjectType"`
+	SubjectIDs     []string `json:"subjectIds"`
+}
+
+type CreateRegulationResponse struct {
+	RegulateID string `json:"regulateId"`
+}
+
+type CreateRegulationResponseData struct {
+	Data CreateRegulationResponse `json:"data"`
+}
+
+// CreateRegulation submits a regulation for the whole workspace, or only for
+// the given source when sourceID isn't empty, and returns its identifier.
+func (c *Client) CreateRegulation(sourceID string, regulation RegulationRequest) (string, error) {
+	requestURL := fmt.Sprintf("%s/regulations", c.HostURL)
+	if sourceID != "" {
+		requestURL = fmt.Sprintf("%s/sources/%s/regulations", c.HostURL, sourceID)
+	}
+
+	regulationData, err := json.Marshal(regulation)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequest("POST", requestURL, strings.NewReader(string(regulationData)))
+	if err != nil {
+		return "", err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return "", err
+	}
+
+	createRegulationResponseData := CreateRegulationResponseData{}
+	err = json.Unmarshal(body, &createRegulationResponseData)
+	if err != nil {
+		return "", err
+	}
+
+	return createRegulationResponseData.Data.RegulateID, nil
+}
+
+func (c *Client) GetRegulation(regulationID string) (*Regulation, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/regulations/%s", c.HostURL, regulationID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	regulationResponseData := RegulationResponseData{}
+	err = json.Unmarshal(body, &regulationResponseData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &regulationResponseData.Data.Regulation, nil
+}
+
+// ListRegulations lists the regulations of the workspace, or of a single
+// source when sourceID isn't empty, optionally filtered by status and type.
+func (c *Client) ListRegulations(sourceID string, status string, regulationTypes []string) ([]Regulation, error) {
+	var regulations []Regulation
+
+	path := "/regulations"
+	if sourceID != "" {
+		path = fmt.Sprintf("/sources/%s/regulations", sourceID)
+	}
+	query := url.Values{}
+	if status != "" {
+		query.Set("status", status)
+	}
+	for i, regulationType := range regulationTypes {
+		query.Set(fmt.Sprintf("regulationTypes.%d", i), regulationType)
+	}
+	if len(query) > 0 {
+		path = fmt.Sprintf("%s?%s", path, query.Encode())
+	}
+
+	err := c.listAll(path, func(body []byte) (*Pagination, error) {
+		regulationsResponseData := RegulationsResponseData{}
+		err := json.Unmarshal(body, &regulationsResponseData)
+		if err != nil {
+			return nil, err
+		}
+		regulations = append(regulations, regulationsResponseData.Data.Regulations...)
+		return &regulationsResponseData.Data.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return regulations, nil
+}
@@ -0,0 +1,109 @@
+package segment
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+var (
+	ReverseETLScheduleStrategies = []string{
+		"MANUAL",
+		"PERIODIC",
+		"SPECIFIC_DAYS",
+	}
+)
+
+type ReverseETLModel struct {
+	ID                    *string                `json:"id,omitempty"`
+	SourceID              string                 `json:"sourceId"`
+	Name                  string                 `json:"name"`
+	Description           string                 `json:"description"`
+	Enabled               bool                   `json:"enabled"`
+	Query                 string                 `json:"query"`
+	QueryIdentifierColumn string                 `json:"queryIdentifierColumn"`
+	ScheduleStrategy      string                 `json:"scheduleStrategy,omitempty"`
+	ScheduleConfig        map[string]interface{} `json:"scheduleConfig,omitempty"`
+}
+
+type ReverseETLModelResponse struct {
+	ReverseETLModel ReverseETLModel `json:"reverseEtlModel"`
+}
+
+type ReverseETLModelResponseData struct {
+	Data ReverseETLModelResponse `json:"data"`
+}
+
+type ReverseETLSchedule struct {
+	Strategy string                 `json:"strategy"`
+	Config   map[string]interface{} `json:"config,omitempty"`
+}
+
+func (c *Client) GetReverseETLModel(modelID string) (*ReverseETLModel, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/reverse-etl-models/%s", c.HostURL, modelID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	modelResponseData := ReverseETLModelResponseData{}
+	err = json.Unmarshal(body, &modelResponseData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &modelResponseData.Data.ReverseETLModel, nil
+}
+
+func (c *Client) CreateReverseETLModel(model ReverseETLModel) (*ReverseETLModel, error) {
+	return c.sendReverseETLModel("POST", fmt.Sprintf("%s/reverse-etl-models", c.HostURL), model)
+}
+
+func (c *Client) UpdateReverseETLModel(modelID string, model ReverseETLModel) (*ReverseETLModel, error) {
+	return c.sendReverseETLModel("PATCH", fmt.Sprintf("%s/reverse-etl-models/%s", c.HostURL, modelID), model)
+}
+
+func (c *Client) sendReverseETLModel(method string, requestURL string, model ReverseETLModel) (*ReverseETLModel, error) {
+	model.ID = nil
+	modelData, err := json.Marshal(model)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(method, requestURL, strings.NewReader(string(modelData)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	modelResponseData := ReverseETLModelResponseData{}
+	err = json.Unmarshal(body, &modelResponseData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &modelResponseData.Data.ReverseETLModel, nil
+}
+
+func (c *Client) DeleteReverseETLModel(modelID string) (string, error) {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/reverse-etl-models/%s", c.HostURL, modelID), nil)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return "", err
+	}
+
+	return "", err
+}
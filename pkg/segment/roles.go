@@ -0,0 +1,39 @@
+package segment
+
+import (
+	"encoding/json"
+)
+
+type Role struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type RolesResponse struct {
+	Roles      []Role     `json:"roles"`
+	Pagination Pagination `json:"pagination"`
+}
+
+type RolesResponseData struct {
+	Data RolesResponse `json:"data"`
+}
+
+func (c *Client) ListRoles() ([]Role, error) {
+	var roles []Role
+
+	err := c.listAll("/roles", func(body []byte) (*Pagination, error) {
+		rolesResponseData := RolesResponseData{}
+		err := json.Unmarshal(body, &rolesResponseData)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, rolesResponseData.Data.Roles...)
+		return &rolesResponseData.Data.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
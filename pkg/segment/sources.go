@@ -71,6 +71,15 @@ type SourceResponseData struct {
 	Data SourceResponse `json:"data"`
 }
 
+type SourcesResponse struct {
+	Sources    []Source   `json:"sources"`
+	Pagination Pagination `json:"pagination"`
+}
+
+type SourcesResponseData struct {
+	Data SourcesResponse `json:"data"`
+}
+
 type SourceRequest struct {
 	ID         *string        `json:"id,omitempty"`
 	Slug       string         `json:"slug"`
@@ -101,13 +110,22 @@ func (c *Client) GetSource(sourceID string) (*Source, error) {
 }
 
 func (c *Client) CreateSource(slug string, enabled bool, name string, sourceSlug string, settings SourceSettings) (*Source, error) {
-	sourceMetadata, _ := c.GetSourceMetadataFromCatalog(sourceSlug)
+	sourceMetadata, err := c.GetSourceMetadataFromCatalog(sourceSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.CreateSourceFromMetadata(slug, enabled, name, sourceMetadata.ID, settings)
+}
 
+// CreateSourceFromMetadata creates a source from a catalog identifier rather
+// than a slug, i.e. for source functions.
+func (c *Client) CreateSourceFromMetadata(slug string, enabled bool, name string, metadataID string, settings SourceSettings) (*Source, error) {
 	newSource := SourceRequest{
 		Slug:       slug,
 		Enabled:    enabled,
 		Name:       name,
-		MetadataID: &sourceMetadata.ID,
+		MetadataID: &metadataID,
 		Settings:   settings,
 	}
 
@@ -179,3 +197,64 @@ func (c *Client) DeleteSource(sourceID string) (string, error) {
 
 	return "", err
 }
+
+func (c *Client) ListSources() ([]Source, error) {
+	var sources []Source
+
+	err := c.listAll("/sources", func(body []byte) (*Pagination, error) {
+		sourcesResponseData := SourcesResponseData{}
+		err := json.Unmarshal(body, &sourcesResponseData)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, sourcesResponseData.Data.Sources...)
+		return &sourcesResponseData.Data.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sources, nil
+}
+
+func (c *Client) GetSourceBySlug(slug string) (*Source, error) {
+	sources, err := c.ListSources()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, source := range sources {
+		if source.Slug == slug {
+			return &sources[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("Source %s not found", slug)
+}
+
+// GetSourceByName looks a source up by name, names aren't unique so it fails
+// if more than one source has the name.
+func (c *Client) GetSourceByName(name string) (*Source, error) {
+	sources, err := c.ListSources()
+	if err != nil {
+		return nil, err
+	}
+
+	var found []*Source
+	var ids []string
+	for i, source := range sources {
+		if source.Name != name {
+			continue
+		}
+		found = append(found, &sources[i])
+		ids = append(ids, *source.ID)
+	}
+	if len(found) == 0 {
+		return nil, fmt.Errorf("Source %s not found", name)
+	}
+	if len(found) > 1 {
+		return nil, fmt.Errorf("More than one source is named %s, use one of their IDs instead: %s", name, strings.Join(ids, ", "))
+	}
+
+	return found[0], nil
+}
@@ -15,58 +15,64 @@ type SourcesCatalogResponse struct {
 	Data SourcesCatalogResponseData `json:"data"`
 }
 
-func (c *Client) GetSourceMetadataFromCatalog(sourceSlug string) (*SourceMetadata, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/catalog/sources?pagination.count=100", c.HostURL), nil)
+type SourceMetadataResponse struct {
+	SourceMetadata SourceMetadata `json:"sourceMetadata"`
+}
 
-	if err != nil {
-		return nil, err
-	}
+type SourceMetadataResponseData struct {
+	Data SourceMetadataResponse `json:"data"`
+}
 
-	body, err := c.doRequest(req)
+func (c *Client) ListSourcesCatalog() ([]SourceMetadata, error) {
+	var catalog []SourceMetadata
 
+	err := c.listAll("/catalog/sources", func(body []byte) (*Pagination, error) {
+		sourcesCatalogResponse := SourcesCatalogResponse{}
+		err := json.Unmarshal(body, &sourcesCatalogResponse)
+		if err != nil {
+			return nil, err
+		}
+		catalog = append(catalog, sourcesCatalogResponse.Data.SourcesCatalog...)
+		return &sourcesCatalogResponse.Data.Pagination, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	sourcesCatalogResponse := SourcesCatalogResponse{}
-	err = json.Unmarshal(body, &sourcesCatalogResponse)
+	return catalog, nil
+}
+
+func (c *Client) GetSourceMetadataFromCatalog(sourceSlug string) (*SourceMetadata, error) {
+	catalog, err := c.ListSourcesCatalog()
 	if err != nil {
 		return nil, err
 	}
 
-	for i, sourceMetadata := range sourcesCatalogResponse.Data.SourcesCatalog {
+	for i, sourceMetadata := range catalog {
 		if sourceMetadata.Slug == sourceSlug {
-			return &sourcesCatalogResponse.Data.SourcesCatalog[i], nil
+			return &catalog[i], nil
 		}
 	}
 
-	for {
-		req, err := http.NewRequest("GET", fmt.Sprintf("%s/catalog/sources?pagination.count=100&pagination.cursor=%s", c.HostURL, *sourcesCatalogResponse.Data.Pagination.Next), nil)
-		if err != nil {
-			return nil, err
-		}
-
-		body, err = c.doRequest(req)
-		if err != nil {
-			return nil, err
-		}
+	return nil, fmt.Errorf("Did not find source %s", sourceSlug)
+}
 
-		sourcesCatalogResponse = SourcesCatalogResponse{}
-		err = json.Unmarshal(body, &sourcesCatalogResponse)
-		if err != nil {
-			return nil, err
-		}
+func (c *Client) GetSourceMetadata(sourceMetadataID string) (*SourceMetadata, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/catalog/sources/%s", c.HostURL, sourceMetadataID), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		for i, sourceMetadata := range sourcesCatalogResponse.Data.SourcesCatalog {
-			if sourceMetadata.Slug == sourceSlug {
-				return &sourcesCatalogResponse.Data.SourcesCatalog[i], nil
-			}
-		}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
 
-		if *sourcesCatalogResponse.Data.Pagination.Next == "" {
-			break
-		}
+	sourceMetadataResponseData := SourceMetadataResponseData{}
+	err = json.Unmarshal(body, &sourceMetadataResponseData)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, fmt.Errorf("Did not find source %s", sourceSlug)
+	return &sourceMetadataResponseData.Data.SourceMetadata, nil
 }
@@ -0,0 +1,144 @@
+package segment
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type Space struct {
+	ID   string `json:"id"`
+	Slug string `json:"slug"`
+	Name string `json:"name"`
+}
+
+type SpaceResponse struct {
+	Space Space `json:"space"`
+}
+
+type SpaceResponseData struct {
+	Data SpaceResponse `json:"data"`
+}
+
+type SpacesResponse struct {
+	Spaces     []Space    `json:"spaces"`
+	Pagination Pagination `json:"pagination"`
+}
+
+type SpacesResponseData struct {
+	Data SpacesResponse `json:"data"`
+}
+
+type SpaceSourcesResponse struct {
+	Sources    []Source   `json:"sources"`
+	Pagination Pagination `json:"pagination"`
+}
+
+type SpaceSourcesResponseData struct {
+	Data SpaceSourcesResponse `json:"data"`
+}
+
+type SpaceSourceRequest struct {
+	SourceID string `json:"sourceId"`
+}
+
+func (c *Client) GetSpace(spaceID string) (*Space, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/spaces/%s", c.HostURL, spaceID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	spaceResponseData := SpaceResponseData{}
+	err = json.Unmarshal(body, &spaceResponseData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &spaceResponseData.Data.Space, nil
+}
+
+func (c *Client) ListSpaces() ([]Space, error) {
+	var spaces []Space
+
+	err := c.listAll("/spaces", func(body []byte) (*Pagination, error) {
+		spacesResponseData := SpacesResponseData{}
+		err := json.Unmarshal(body, &spacesResponseData)
+		if err != nil {
+			return nil, err
+		}
+		spaces = append(spaces, spacesResponseData.Data.Spaces...)
+		return &spacesResponseData.Data.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return spaces, nil
+}
+
+// GetSpaceByName looks a space up by name, names are compared case
+// insensitively.
+func (c *Client) GetSpaceByName(name string) (*Space, error) {
+	spaces, err := c.ListSpaces()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, space := range spaces {
+		if strings.EqualFold(space.Name, name) {
+			return &spaces[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("Space %s not found", name)
+}
+
+func (c *Client) ListSpaceSources(spaceID string) ([]Source, error) {
+	var sources []Source
+
+	err := c.listAll(fmt.Sprintf("/spaces/%s/sources", spaceID), func(body []byte) (*Pagination, error) {
+		sourcesResponseData := SpaceSourcesResponseData{}
+		err := json.Unmarshal(body, &sourcesResponseData)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, sourcesResponseData.Data.Sources...)
+		return &sourcesResponseData.Data.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sources, nil
+}
+
+func (c *Client) AddSourceToSpace(spaceID string, sourceID string) error {
+	sourceData, err := json.Marshal(SpaceSourceRequest{SourceID: sourceID})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/spaces/%s/sources", c.HostURL, spaceID), strings.NewReader(string(sourceData)))
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	return err
+}
+
+func (c *Client) RemoveSourceFromSpace(spaceID string, sourceID string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/spaces/%s/sources/%s", c.HostURL, spaceID, sourceID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	return err
+}
@@ -0,0 +1,277 @@
+package segment
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+var (
+	TrackingPlanTypes = []string{
+		"ENGAGE",
+		"LIVE",
+		"PROPERTY_LIBRARY",
+		"RULE_LIBRARY",
+		"TEMPLATE",
+	}
+	TrackingPlanRuleTypes = []string{
+		"COMMON",
+		"GROUP",
+		"IDENTIFY",
+		"PAGE",
+		"SCREEN",
+		"TRACK",
+	}
+)
+
+type TrackingPlan struct {
+	ID          *string `json:"id,omitempty"`
+	Name        string  `json:"name"`
+	Slug        string  `json:"slug"`
+	Description string  `json:"description"`
+	Type        string  `json:"type"`
+	CreatedAt   string  `json:"createdAt"`
+	UpdatedAt   string  `json:"updatedAt"`
+}
+
+type TrackingPlanResponse struct {
+	TrackingPlan TrackingPlan `json:"trackingPlan"`
+}
+
+type TrackingPlanResponseData struct {
+	Data TrackingPlanResponse `json:"data"`
+}
+
+type TrackingPlanRequest struct {
+	Name        string `json:"name"`
+	Type        string `json:"type,omitempty"`
+	Description string `json:"description"`
+}
+
+type TrackingPlanRule struct {
+	Type       string                 `json:"type"`
+	Key        string                 `json:"key,omitempty"`
+	Version    int                    `json:"version"`
+	JSONSchema map[string]interface{} `json:"jsonSchema,omitempty"`
+}
+
+type TrackingPlanRulesResponse struct {
+	Rules      []TrackingPlanRule `json:"rules"`
+	Pagination Pagination         `json:"pagination"`
+}
+
+type TrackingPlanRulesResponseData struct {
+	Data TrackingPlanRulesResponse `json:"data"`
+}
+
+type TrackingPlanRulesRequest struct {
+	Rules []TrackingPlanRule `json:"rules"`
+}
+
+type TrackingPlanSourcesResponse struct {
+	Sources    []Source   `json:"sources"`
+	Pagination Pagination `json:"pagination"`
+}
+
+type TrackingPlanSourcesResponseData struct {
+	Data TrackingPlanSourcesResponse `json:"data"`
+}
+
+type TrackingPlanSourceRequest struct {
+	SourceID string `json:"sourceId"`
+}
+
+func (c *Client) GetTrackingPlan(trackingPlanID string) (*TrackingPlan, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/tracking-plans/%s", c.HostURL, trackingPlanID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	trackingPlanResponseData := TrackingPlanResponseData{}
+	err = json.Unmarshal(body, &trackingPlanResponseData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &trackingPlanResponseData.Data.TrackingPlan, nil
+}
+
+func (c *Client) CreateTrackingPlan(name string, planType string, description string) (*TrackingPlan, error) {
+	newTrackingPlan := TrackingPlanRequest{
+		Name:        name,
+		Type:        planType,
+		Description: description,
+	}
+
+	newTrackingPlanData, err := json.Marshal(newTrackingPlan)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/tracking-plans", c.HostURL), strings.NewReader(string(newTrackingPlanData)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	trackingPlanResponseData := TrackingPlanResponseData{}
+	err = json.Unmarshal(body, &trackingPlanResponseData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &trackingPlanResponseData.Data.TrackingPlan, nil
+}
+
+func (c *Client) UpdateTrackingPlan(trackingPlanID string, name string, description string) (*TrackingPlan, error) {
+	updatedTrackingPlan := TrackingPlanRequest{
+		Name:        name,
+		Description: description,
+	}
+
+	updatedTrackingPlanData, err := json.Marshal(updatedTrackingPlan)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/tracking-plans/%s", c.HostURL, trackingPlanID), strings.NewReader(string(updatedTrackingPlanData)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	trackingPlanResponseData := TrackingPlanResponseData{}
+	err = json.Unmarshal(body, &trackingPlanResponseData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &trackingPlanResponseData.Data.TrackingPlan, nil
+}
+
+func (c *Client) DeleteTrackingPlan(trackingPlanID string) (string, error) {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/tracking-plans/%s", c.HostURL, trackingPlanID), nil)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return "", err
+	}
+
+	return "", err
+}
+
+func (c *Client) ListTrackingPlanRules(trackingPlanID string) ([]TrackingPlanRule, error) {
+	var rules []TrackingPlanRule
+
+	err := c.listAll(fmt.Sprintf("/tracking-plans/%s/rules", trackingPlanID), func(body []byte) (*Pagination, error) {
+		rulesResponseData := TrackingPlanRulesResponseData{}
+		err := json.Unmarshal(body, &rulesResponseData)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rulesResponseData.Data.Rules...)
+		return &rulesResponseData.Data.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// ReplaceTrackingPlanRules replaces every rule in the tracking plan.
+func (c *Client) ReplaceTrackingPlanRules(trackingPlanID string, rules []TrackingPlanRule) error {
+	return c.sendTrackingPlanRules("PUT", trackingPlanID, rules)
+}
+
+// UpdateTrackingPlanRules creates or updates the given rules, leaving any
+// other rules in the tracking plan untouched.
+func (c *Client) UpdateTrackingPlanRules(trackingPlanID string, rules []TrackingPlanRule) error {
+	return c.sendTrackingPlanRules("PATCH", trackingPlanID, rules)
+}
+
+// RemoveTrackingPlanRules removes the given rules, matched on type, key and
+// version.
+func (c *Client) RemoveTrackingPlanRules(trackingPlanID string, rules []TrackingPlanRule) error {
+	return c.sendTrackingPlanRules("DELETE", trackingPlanID, rules)
+}
+
+func (c *Client) sendTrackingPlanRules(method string, trackingPlanID string, rules []TrackingPlanRule) error {
+	if rules == nil {
+		rules = []TrackingPlanRule{}
+	}
+	rulesData, err := json.Marshal(TrackingPlanRulesRequest{Rules: rules})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(method, fmt.Sprintf("%s/tracking-plans/%s/rules", c.HostURL, trackingPlanID), strings.NewReader(string(rulesData)))
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	return err
+}
+
+func (c *Client) ListTrackingPlanSources(trackingPlanID string) ([]Source, error) {
+	var sources []Source
+
+	err := c.listAll(fmt.Sprintf("/tracking-plans/%s/sources", trackingPlanID), func(body []byte) (*Pagination, error) {
+		sourcesResponseData := TrackingPlanSourcesResponseData{}
+		err := json.Unmarshal(body, &sourcesResponseData)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, sourcesResponseData.Data.Sources...)
+		return &sourcesResponseData.Data.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sources, nil
+}
+
+func (c *Client) AddSourceToTrackingPlan(trackingPlanID string, sourceID string) error {
+	sourceData, err := json.Marshal(TrackingPlanSourceRequest{SourceID: sourceID})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/tracking-plans/%s/sources", c.HostURL, trackingPlanID), strings.NewReader(string(sourceData)))
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	return err
+}
+
+func (c *Client) RemoveSourceFromTrackingPlan(trackingPlanID string, sourceID string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/tracking-plans/%s/sources?sourceId=%s", c.HostURL, trackingPlanID, url.QueryEscape(sourceID)), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	return err
+}
@@ -0,0 +1,112 @@
+package segment
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type PropertyRename struct {
+	OldName string `json:"oldName"`
+	NewName string `json:"newName"`
+}
+
+type PropertyValueTransformation struct {
+	PropertyPaths []string `json:"propertyPaths"`
+	PropertyValue string   `json:"propertyValue"`
+}
+
+type Transformation struct {
+	ID                           *string                       `json:"id,omitempty"`
+	Name                         string                        `json:"name"`
+	SourceID                     string                        `json:"sourceId"`
+	DestinationMetadataID        string                        `json:"destinationMetadataId,omitempty"`
+	Enabled                      bool                          `json:"enabled"`
+	If                           string                        `json:"if"`
+	NewEventName                 string                        `json:"newEventName,omitempty"`
+	PropertyRenames              []PropertyRename              `json:"propertyRenames"`
+	PropertyValueTransformations []PropertyValueTransformation `json:"propertyValueTransformations"`
+}
+
+type TransformationResponse struct {
+	Transformation Transformation `json:"transformation"`
+}
+
+type TransformationResponseData struct {
+	Data TransformationResponse `json:"data"`
+}
+
+func (c *Client) GetTransformation(transformationID string) (*Transformation, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/transformations/%s", c.HostURL, transformationID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	transformationResponseData := TransformationResponseData{}
+	err = json.Unmarshal(body, &transformationResponseData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &transformationResponseData.Data.Transformation, nil
+}
+
+func (c *Client) CreateTransformation(transformation Transformation) (*Transformation, error) {
+	return c.sendTransformation("POST", fmt.Sprintf("%s/transformations", c.HostURL), transformation)
+}
+
+func (c *Client) UpdateTransformation(transformationID string, transformation Transformation) (*Transformation, error) {
+	return c.sendTransformation("PATCH", fmt.Sprintf("%s/transformations/%s", c.HostURL, transformationID), transformation)
+}
+
+func (c *Client) sendTransformation(method string, requestURL string, transformation Transformation) (*Transformation, error) {
+	transformation.ID = nil
+	if transformation.PropertyRenames == nil {
+		transformation.PropertyRenames = []PropertyRename{}
+	}
+	if transformation.PropertyValueTransformations == nil {
+		transformation.PropertyValueTransformations = []PropertyValueTransformation{}
+	}
+	transformationData, err := json.Marshal(transformation)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(method, requestURL, strings.NewReader(string(transformationData)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	transformationResponseData := TransformationResponseData{}
+	err = json.Unmarshal(body, &transformationResponseData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &transformationResponseData.Data.Transformation, nil
+}
+
+func (c *Client) DeleteTransformation(transformationID string) (string, error) {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/transformations/%s", c.HostURL, transformationID), nil)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return "", err
+	}
+
+	return "", err
+}
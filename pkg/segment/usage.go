@@ -0,0 +1,126 @@
+package segment
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+type DailyAPICallsUsage struct {
+	SourceID  string `json:"sourceId,omitempty"`
+	Timestamp string `json:"timestamp"`
+	APICalls  int    `json:"apiCalls"`
+}
+
+type DailyMTUUsage struct {
+	SourceID            string `json:"sourceId,omitempty"`
+	Timestamp           string `json:"timestamp"`
+	Anonymous           int    `json:"anonymous"`
+	AnonymousIdentified int    `json:"anonymousIdentified"`
+	Identified          int    `json:"identified"`
+	NeverIdentified     int    `json:"neverIdentified"`
+}
+
+type APICallsUsageResponse struct {
+	DailyWorkspaceAPICallsUsage []DailyAPICallsUsage `json:"dailyWorkspaceAPICallsUsage"`
+	DailyPerSourceAPICallsUsage []DailyAPICallsUsage `json:"dailyPerSourceAPICallsUsage"`
+	Pagination                  Pagination           `json:"pagination"`
+}
+
+type APICallsUsageResponseData struct {
+	Data APICallsUsageResponse `json:"data"`
+}
+
+type MTUUsageResponse struct {
+	DailyWorkspaceMTUUsage []DailyMTUUsage `json:"dailyWorkspaceMTUUsage"`
+	DailyPerSourceMTUUsage []DailyMTUUsage `json:"dailyPerSourceMTUUsage"`
+	Pagination             Pagination      `json:"pagination"`
+}
+
+type MTUUsageResponseData struct {
+	Data MTUUsageResponse `json:"data"`
+}
+
+// ListAPICallsUsage returns the daily API calls of the workspace for the
+// period starting on the given date, formatted as YYYY-MM-DD.
+func (c *Client) ListAPICallsUsage(period string) ([]DailyAPICallsUsage, error) {
+	var usage []DailyAPICallsUsage
+
+	err := c.listAll(usagePath("/usage/api-calls/daily", period), func(body []byte) (*Pagination, error) {
+		usageResponseData := APICallsUsageResponseData{}
+		err := json.Unmarshal(body, &usageResponseData)
+		if err != nil {
+			return nil, err
+		}
+		usage = append(usage, usageResponseData.Data.DailyWorkspaceAPICallsUsage...)
+		return &usageResponseData.Data.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return usage, nil
+}
+
+func (c *Client) ListSourceAPICallsUsage(period string) ([]DailyAPICallsUsage, error) {
+	var usage []DailyAPICallsUsage
+
+	err := c.listAll(usagePath("/usage/api-calls/sources/daily", period), func(body []byte) (*Pagination, error) {
+		usageResponseData := APICallsUsageResponseData{}
+		err := json.Unmarshal(body, &usageResponseData)
+		if err != nil {
+			return nil, err
+		}
+		usage = append(usage, usageResponseData.Data.DailyPerSourceAPICallsUsage...)
+		return &usageResponseData.Data.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return usage, nil
+}
+
+// ListMTUUsage returns the daily monthly tracked users of the workspace for
+// the period starting on the given date, formatted as YYYY-MM-DD.
+func (c *Client) ListMTUUsage(period string) ([]DailyMTUUsage, error) {
+	var usage []DailyMTUUsage
+
+	err := c.listAll(usagePath("/usage/mtu/daily", period), func(body []byte) (*Pagination, error) {
+		usageResponseData := MTUUsageResponseData{}
+		err := json.Unmarshal(body, &usageResponseData)
+		if err != nil {
+			return nil, err
+		}
+		usage = append(usage, usageResponseData.Data.DailyWorkspaceMTUUsage...)
+		return &usageResponseData.Data.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return usage, nil
+}
+
+func (c *Client) ListSourceMTUUsage(period string) ([]DailyMTUUsage, error) {
+	var usage []DailyMTUUsage
+
+	err := c.listAll(usagePath("/usage/mtu/sources/daily", period), func(body []byte) (*Pagination, error) {
+		usageResponseData := MTUUsageResponseData{}
+		err := json.Unmarshal(body, &usageResponseData)
+		if err != nil {
+			return nil, err
+		}
+		usage = append(usage, usageResponseData.Data.DailyPerSourceMTUUsage...)
+		return &usageResponseData.Data.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return usage, nil
+}
+
+func usagePath(path string, period string) string {
+	return fmt.Sprintf("%s?period=%s", path, url.QueryEscape(period))
+}
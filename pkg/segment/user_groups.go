@@ -0,0 +1,228 @@
+package segment
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type UserGroup struct {
+	ID          *string      `json:"id,omitempty"`
+	Name        string       `json:"name"`
+	MemberCount int          `json:"memberCount"`
+	Permissions []Permission `json:"permissions,omitempty"`
+}
+
+type UserGroupResponse struct {
+	UserGroup UserGroup `json:"userGroup"`
+}
+
+type UserGroupResponseData struct {
+	Data UserGroupResponse `json:"data"`
+}
+
+type UserGroupsResponse struct {
+	UserGroups []UserGroup `json:"userGroups"`
+	Pagination Pagination  `json:"pagination"`
+}
+
+type UserGroupsResponseData struct {
+	Data UserGroupsResponse `json:"data"`
+}
+
+type UserGroupRequest struct {
+	Name string `json:"name"`
+}
+
+type UserGroupMembersRequest struct {
+	Emails []string `json:"emails"`
+}
+
+type UserGroupInvitesResponse struct {
+	Emails     []string   `json:"emails"`
+	Pagination Pagination `json:"pagination"`
+}
+
+type UserGroupInvitesResponseData struct {
+	Data UserGroupInvitesResponse `json:"data"`
+}
+
+func (c *Client) GetUserGroup(userGroupID string) (*UserGroup, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/groups/%s", c.HostURL, userGroupID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	userGroupResponseData := UserGroupResponseData{}
+	err = json.Unmarshal(body, &userGroupResponseData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &userGroupResponseData.Data.UserGroup, nil
+}
+
+func (c *Client) ListUserGroups() ([]UserGroup, error) {
+	var userGroups []UserGroup
+
+	err := c.listAll("/groups", func(body []byte) (*Pagination, error) {
+		userGroupsResponseData := UserGroupsResponseData{}
+		err := json.Unmarshal(body, &userGroupsResponseData)
+		if err != nil {
+			return nil, err
+		}
+		userGroups = append(userGroups, userGroupsResponseData.Data.UserGroups...)
+		return &userGroupsResponseData.Data.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return userGroups, nil
+}
+
+func (c *Client) CreateUserGroup(name string) (*UserGroup, error) {
+	return c.sendUserGroup("POST", fmt.Sprintf("%s/groups", c.HostURL), name)
+}
+
+func (c *Client) UpdateUserGroup(userGroupID string, name string) (*UserGroup, error) {
+	return c.sendUserGroup("PATCH", fmt.Sprintf("%s/groups/%s", c.HostURL, userGroupID), name)
+}
+
+func (c *Client) sendUserGroup(method string, requestURL string, name string) (*UserGroup, error) {
+	userGroupData, err := json.Marshal(UserGroupRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(method, requestURL, strings.NewReader(string(userGroupData)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	userGroupResponseData := UserGroupResponseData{}
+	err = json.Unmarshal(body, &userGroupResponseData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &userGroupResponseData.Data.UserGroup, nil
+}
+
+func (c *Client) DeleteUserGroup(userGroupID string) (string, error) {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/groups/%s", c.HostURL, userGroupID), nil)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return "", err
+	}
+
+	return "", err
+}
+
+// ReplaceUserGroupPermissions sets the permissions granted to members of the
+// group, removing any not in the list.
+func (c *Client) ReplaceUserGroupPermissions(userGroupID string, permissions []Permission) error {
+	return c.sendPermissions(fmt.Sprintf("%s/groups/%s/permissions", c.HostURL, userGroupID), permissions)
+}
+
+// ListUserGroupMembers returns the emails of every member of the group,
+// including people who have been invited to the workspace through the group
+// but haven't accepted yet.
+func (c *Client) ListUserGroupMembers(userGroupID string) ([]string, error) {
+	var emails []string
+
+	err := c.listAll(fmt.Sprintf("/groups/%s/users", userGroupID), func(body []byte) (*Pagination, error) {
+		usersResponseData := UsersResponseData{}
+		err := json.Unmarshal(body, &usersResponseData)
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range usersResponseData.Data.Users {
+			emails = append(emails, user.Email)
+		}
+		return &usersResponseData.Data.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.listAll(fmt.Sprintf("/groups/%s/invites", userGroupID), func(body []byte) (*Pagination, error) {
+		invitesResponseData := UserGroupInvitesResponseData{}
+		err := json.Unmarshal(body, &invitesResponseData)
+		if err != nil {
+			return nil, err
+		}
+		emails = append(emails, invitesResponseData.Data.Emails...)
+		return &invitesResponseData.Data.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return emails, nil
+}
+
+// ReplaceUserGroupMembers sets the members of the group to exactly the given
+// emails, anyone not yet in the workspace is sent an invite.
+func (c *Client) ReplaceUserGroupMembers(userGroupID string, emails []string) error {
+	return c.sendUserGroupMembers("PUT", userGroupID, emails)
+}
+
+// AddUserGroupMembers adds the given emails to the group, leaving any other
+// members untouched.
+func (c *Client) AddUserGroupMembers(userGroupID string, emails []string) error {
+	return c.sendUserGroupMembers("POST", userGroupID, emails)
+}
+
+func (c *Client) sendUserGroupMembers(method string, userGroupID string, emails []string) error {
+	if emails == nil {
+		emails = []string{}
+	}
+	membersData, err := json.Marshal(UserGroupMembersRequest{Emails: emails})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(method, fmt.Sprintf("%s/groups/%s/users", c.HostURL, userGroupID), strings.NewReader(string(membersData)))
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	return err
+}
+
+func (c *Client) RemoveUserGroupMembers(userGroupID string, emails []string) error {
+	if len(emails) == 0 {
+		return nil
+	}
+
+	query := url.Values{}
+	for i, email := range emails {
+		query.Set(fmt.Sprintf("emails.%d", i), email)
+	}
+
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/groups/%s/users?%s", c.HostURL, userGroupID, query.Encode()), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	return err
+}
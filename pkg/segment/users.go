@@ -0,0 +1,226 @@
+package segment
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+var (
+	PermissionResourceTypes = []string{
+		"WORKSPACE",
+		"SOURCE",
+		"SPACE",
+	}
+)
+
+type PermissionResource struct {
+	ID     string  `json:"id"`
+	Type   string  `json:"type"`
+	Labels []Label `json:"labels,omitempty"`
+}
+
+type Permission struct {
+	RoleID    string               `json:"roleId"`
+	RoleName  string               `json:"roleName,omitempty"`
+	Resources []PermissionResource `json:"resources"`
+}
+
+type User struct {
+	ID          string       `json:"id"`
+	Name        string       `json:"name"`
+	Email       string       `json:"email"`
+	Permissions []Permission `json:"permissions"`
+}
+
+type UserResponse struct {
+	User User `json:"user"`
+}
+
+type UserResponseData struct {
+	Data UserResponse `json:"data"`
+}
+
+type UsersResponse struct {
+	Users      []User     `json:"users"`
+	Pagination Pagination `json:"pagination"`
+}
+
+type UsersResponseData struct {
+	Data UsersResponse `json:"data"`
+}
+
+type Invite struct {
+	Email       string       `json:"email"`
+	Permissions []Permission `json:"permissions,omitempty"`
+}
+
+type InvitesRequest struct {
+	Invites []Invite `json:"invites"`
+}
+
+type InvitesResponse struct {
+	Invites    []string   `json:"invites"`
+	Pagination Pagination `json:"pagination"`
+}
+
+type InvitesResponseData struct {
+	Data InvitesResponse `json:"data"`
+}
+
+type PermissionsRequest struct {
+	Permissions []Permission `json:"permissions"`
+}
+
+func (c *Client) GetUser(userID string) (*User, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/users/%s", c.HostURL, userID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	userResponseData := UserResponseData{}
+	err = json.Unmarshal(body, &userResponseData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &userResponseData.Data.User, nil
+}
+
+func (c *Client) ListUsers() ([]User, error) {
+	var users []User
+
+	err := c.listAll("/users", func(body []byte) (*Pagination, error) {
+		usersResponseData := UsersResponseData{}
+		err := json.Unmarshal(body, &usersResponseData)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, usersResponseData.Data.Users...)
+		return &usersResponseData.Data.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// GetUserByEmail looks a user up by email, emails are compared case
+// insensitively.
+func (c *Client) GetUserByEmail(email string) (*User, error) {
+	users, err := c.ListUsers()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, user := range users {
+		if strings.EqualFold(user.Email, email) {
+			return &users[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("User %s not found", email)
+}
+
+func (c *Client) DeleteUser(userID string) (string, error) {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/users?userIds.0=%s", c.HostURL, url.QueryEscape(userID)), nil)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return "", err
+	}
+
+	return "", err
+}
+
+func (c *Client) ListInvites() ([]string, error) {
+	var invites []string
+
+	err := c.listAll("/invites", func(body []byte) (*Pagination, error) {
+		invitesResponseData := InvitesResponseData{}
+		err := json.Unmarshal(body, &invitesResponseData)
+		if err != nil {
+			return nil, err
+		}
+		invites = append(invites, invitesResponseData.Data.Invites...)
+		return &invitesResponseData.Data.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return invites, nil
+}
+
+func (c *Client) CreateInvite(email string, permissions []Permission) error {
+	newInvites := InvitesRequest{
+		Invites: []Invite{
+			{
+				Email:       email,
+				Permissions: permissions,
+			},
+		},
+	}
+
+	newInvitesData, err := json.Marshal(newInvites)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/invites", c.HostURL), strings.NewReader(string(newInvitesData)))
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	return err
+}
+
+func (c *Client) DeleteInvite(email string) (string, error) {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/invites?emails.0=%s", c.HostURL, url.QueryEscape(email)), nil)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return "", err
+	}
+
+	return "", err
+}
+
+// ReplaceUserPermissions sets the permissions granted directly to the user,
+// removing any not in the list.
+func (c *Client) ReplaceUserPermissions(userID string, permissions []Permission) error {
+	return c.sendPermissions(fmt.Sprintf("%s/users/%s/permissions", c.HostURL, userID), permissions)
+}
+
+func (c *Client) sendPermissions(requestURL string, permissions []Permission) error {
+	if permissions == nil {
+		permissions = []Permission{}
+	}
+	permissionsData, err := json.Marshal(PermissionsRequest{Permissions: permissions})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("PUT", requestURL, strings.NewReader(string(permissionsData)))
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	return err
+}
@@ -43,6 +43,15 @@ type WarehouseResponseData struct {
 	Data WarehouseResponse `json:"data"`
 }
 
+type WarehousesResponse struct {
+	Warehouses []Warehouse `json:"warehouses"`
+	Pagination Pagination  `json:"pagination"`
+}
+
+type WarehousesResponseData struct {
+	Data WarehousesResponse `json:"data"`
+}
+
 type WarehouseRequest struct {
 	ID         *string           `json:"id,omitempty"`
 	Name       string            `json:"name"`
@@ -76,7 +85,10 @@ func (c *Client) GetWarehouse(warehouseID string) (*Warehouse, error) {
 }
 
 func (c *Client) CreateWarehouse(enabled bool, name string, warehouseSlug string, settings WarehouseSettings) (*Warehouse, error) {
-	warehouseMetadata, _ := c.GetWarehouseMetadataFromCatalog(warehouseSlug)
+	warehouseMetadata, err := c.GetWarehouseMetadataFromCatalog(warehouseSlug)
+	if err != nil {
+		return nil, err
+	}
 
 	newWarehouse := WarehouseRequest{
 		Enabled:    enabled,
@@ -152,3 +164,78 @@ func (c *Client) DeleteWarehouse(warehouseID string) (string, error) {
 
 	return "", err
 }
+
+func (c *Client) ListWarehouses() ([]Warehouse, error) {
+	var warehouses []Warehouse
+
+	err := c.listAll("/warehouses", func(body []byte) (*Pagination, error) {
+		warehousesResponseData := WarehousesResponseData{}
+		err := json.Unmarshal(body, &warehousesResponseData)
+		if err != nil {
+			return nil, err
+		}
+		for _, warehouse := range warehousesResponseData.Data.Warehouses {
+			if warehouse.Name == "" {
+				warehouse.Name = warehouse.Settings.Name
+			}
+			warehouses = append(warehouses, warehouse)
+		}
+		return &warehousesResponseData.Data.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return warehouses, nil
+}
+
+// GetWarehouseByName looks a warehouse up by name, names aren't unique so it
+// fails if more than one warehouse has the name.
+func (c *Client) GetWarehouseByName(name string) (*Warehouse, error) {
+	warehouses, err := c.ListWarehouses()
+	if err != nil {
+		return nil, err
+	}
+
+	var found []*Warehouse
+	var ids []string
+	for i, warehouse := range warehouses {
+		if warehouse.Name != name {
+			continue
+		}
+		found = append(found, &warehouses[i])
+		ids = append(ids, *warehouse.ID)
+	}
+	if len(found) == 0 {
+		return nil, fmt.Errorf("Warehouse %s not found", name)
+	}
+	if len(found) > 1 {
+		return nil, fmt.Errorf("More than one warehouse is named %s, use one of their IDs instead: %s", name, strings.Join(ids, ", "))
+	}
+
+	return found[0], nil
+}
+
+func (c *Client) ListSourceConnectedWarehouses(sourceID string) ([]Warehouse, error) {
+	var warehouses []Warehouse
+
+	err := c.listAll(fmt.Sprintf("/sources/%s/connected-warehouses", sourceID), func(body []byte) (*Pagination, error) {
+		warehousesResponseData := WarehousesResponseData{}
+		err := json.Unmarshal(body, &warehousesResponseData)
+		if err != nil {
+			return nil, err
+		}
+		for _, warehouse := range warehousesResponseData.Data.Warehouses {
+			if warehouse.Name == "" {
+				warehouse.Name = warehouse.Settings.Name
+			}
+			warehouses = append(warehouses, warehouse)
+		}
+		return &warehousesResponseData.Data.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return warehouses, nil
+}
@@ -15,58 +15,64 @@ type WarehousesCatalogResponse struct {
 	Data WarehousesCatalogResponseData `json:"data"`
 }
 
-func (c *Client) GetWarehouseMetadataFromCatalog(warehouseSlug string) (*WarehouseMetadata, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/catalog/warehouses?pagination.count=100", c.HostURL), nil)
+type WarehouseMetadataResponse struct {
+	WarehouseMetadata WarehouseMetadata `json:"warehouseMetadata"`
+}
 
-	if err != nil {
-		return nil, err
-	}
+type WarehouseMetadataResponseData struct {
+	Data WarehouseMetadataResponse `json:"data"`
+}
 
-	body, err := c.doRequest(req)
+func (c *Client) ListWarehousesCatalog() ([]WarehouseMetadata, error) {
+	var catalog []WarehouseMetadata
 
+	err := c.listAll("/catalog/warehouses", func(body []byte) (*Pagination, error) {
+		warehousesCatalogResponse := WarehousesCatalogResponse{}
+		err := json.Unmarshal(body, &warehousesCatalogResponse)
+		if err != nil {
+			return nil, err
+		}
+		catalog = append(catalog, warehousesCatalogResponse.Data.WarehousesCatalog...)
+		return &warehousesCatalogResponse.Data.Pagination, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	warehousesCatalogResponse := WarehousesCatalogResponse{}
-	err = json.Unmarshal(body, &warehousesCatalogResponse)
+	return catalog, nil
+}
+
+func (c *Client) GetWarehouseMetadataFromCatalog(warehouseSlug string) (*WarehouseMetadata, error) {
+	catalog, err := c.ListWarehousesCatalog()
 	if err != nil {
 		return nil, err
 	}
 
-	for i, warehouseMetadata := range warehousesCatalogResponse.Data.WarehousesCatalog {
+	for i, warehouseMetadata := range catalog {
 		if warehouseMetadata.Slug == warehouseSlug {
-			return &warehousesCatalogResponse.Data.WarehousesCatalog[i], nil
+			return &catalog[i], nil
 		}
 	}
 
-	for {
-		req, err := http.NewRequest("GET", fmt.Sprintf("%s/catalog/warehouses?pagination.count=100&pagination.cursor=%s", c.HostURL, *warehousesCatalogResponse.Data.Pagination.Next), nil)
-		if err != nil {
-			return nil, err
-		}
-
-		body, err = c.doRequest(req)
-		if err != nil {
-			return nil, err
-		}
+	return nil, fmt.Errorf("Did not find warehouse %s", warehouseSlug)
+}
 
-		warehousesCatalogResponse = WarehousesCatalogResponse{}
-		err = json.Unmarshal(body, &warehousesCatalogResponse)
-		if err != nil {
-			return nil, err
-		}
+func (c *Client) GetWarehouseMetadata(warehouseMetadataID string) (*WarehouseMetadata, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/catalog/warehouses/%s", c.HostURL, warehouseMetadataID), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		for i, warehouseMetadata := range warehousesCatalogResponse.Data.WarehousesCatalog {
-			if warehouseMetadata.Slug == warehouseSlug {
-				return &warehousesCatalogResponse.Data.WarehousesCatalog[i], nil
-			}
-		}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
 
-		if *warehousesCatalogResponse.Data.Pagination.Next == "" {
-			break
-		}
+	warehouseMetadataResponseData := WarehouseMetadataResponseData{}
+	err = json.Unmarshal(body, &warehouseMetadataResponseData)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, fmt.Errorf("Did not find warehouse %s", warehouseSlug)
+	return &warehouseMetadataResponseData.Data.WarehouseMetadata, nil
 }